@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/samratjha96/podscript/agents"
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/providers"
+)
+
+// AgentCmd runs one of the built-in agents (summarizer, show-notes,
+// chapterizer) over a transcript or scratch directory, letting the model
+// call back into podscript's own tools (fetch_url, read_file, modify_file,
+// dir_tree, search_transcript) rather than requiring the whole transcript be
+// pasted into the prompt up front.
+type AgentCmd struct {
+	OpenAIAPIKey       string           `help:"OpenAI API key" env:"OPENAI_API_KEY" hidden:""`
+	AnthropicAPIKey    string           `help:"Anthropic API key" env:"ANTHROPIC_API_KEY" hidden:""`
+	GroqAPIKey         string           `help:"Groq API key" env:"GROQ_API_KEY" hidden:""`
+	GeminiAPIKey       string           `help:"Gemini API key" env:"GEMINI_API_KEY" hidden:""`
+	AWSRegion          string           `help:"AWS Region" env:"AWS_REGION" hidden:""`
+	AWSAccessKeyID     string           `help:"AWS Access Key ID" env:"AWS_ACCESS_KEY_ID" hidden:""`
+	AWSSecretAccessKey string           `help:"AWS Secret Access Key ID" env:"AWS_SECRET_ACCESS_KEY" hidden:""`
+	AWSSessionToken    string           `help:"AWS Session Token" env:"AWS_SESSION_TOKEN" hidden:""`
+	Model              backend.LLMModel `help:"Model to use" default:"gpt-4o" short:"m"`
+	Agent              string           `help:"Agent to run: summarizer, show-notes, or chapterizer" default:"summarizer"`
+	Path               string           `help:"Directory the agent's tools may read and write" default:"." short:"p"`
+	MaxElapsed         time.Duration    `name:"max-elapsed" help:"Give up on the whole agent run after this long" default:"10m"`
+	Prompt             string           `arg:"" help:"Instructions for the agent, e.g. a path to the transcript to work from"`
+}
+
+// credentials gathers the credentials AgentCmd was given into the shape
+// backend.NewProvider expects, mirroring YTTCmd.credentials().
+func (cmd *AgentCmd) credentials() backend.Credentials {
+	return backend.Credentials{
+		OpenAIAPIKey:       cmd.OpenAIAPIKey,
+		AnthropicAPIKey:    cmd.AnthropicAPIKey,
+		GroqAPIKey:         cmd.GroqAPIKey,
+		GeminiAPIKey:       cmd.GeminiAPIKey,
+		AWSRegion:          cmd.AWSRegion,
+		AWSAccessKeyID:     cmd.AWSAccessKeyID,
+		AWSSecretAccessKey: cmd.AWSSecretAccessKey,
+		AWSSessionToken:    cmd.AWSSessionToken,
+	}
+}
+
+// newAgentLLMClient resolves cmd.Model to a providers.Provider the same way
+// the transcription pipeline does, instead of maintaining a second,
+// hand-rolled provider client per backend.
+func (cmd *AgentCmd) newAgentLLMClient() (agents.LLMClient, error) {
+	provider, err := backend.ProviderForModel(cmd.Model)
+	if err != nil {
+		return nil, fmt.Errorf("agent mode does not yet support model: %s", cmd.Model)
+	}
+	p, err := backend.NewProvider(provider, cmd.Model, cmd.credentials())
+	if err != nil {
+		return nil, err
+	}
+	return &providerAgentClient{provider: p, model: string(cmd.Model)}, nil
+}
+
+func (cmd *AgentCmd) Run() error {
+	client, err := cmd.newAgentLLMClient()
+	if err != nil {
+		return err
+	}
+
+	agent, err := agents.NewPreset(cmd.Agent, client, cmd.Path, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if cmd.MaxElapsed > 0 {
+		if setter, ok := client.(*providerAgentClient); ok {
+			setter.maxElapsed = cmd.MaxElapsed
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cmd.MaxElapsed)
+		defer cancel()
+	}
+
+	result, err := agent.Run(ctx, cmd.Prompt)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
+
+// providerAgentClient adapts a providers.Provider to agents.LLMClient, so the
+// agent command's tool-call loop drives the same OpenAI/Anthropic/Groq/
+// Gemini/Bedrock request-and-response conversion the transcription pipeline
+// uses, rather than a second copy of it.
+type providerAgentClient struct {
+	provider   providers.Provider
+	model      string
+	maxElapsed time.Duration
+}
+
+func (c *providerAgentClient) Chat(ctx context.Context, messages []agents.Message, tools []agents.ToolSpec) (agents.ChatResponse, error) {
+	chunks, err := c.provider.Chat(ctx, providers.ChatRequest{
+		Model:      c.model,
+		Messages:   toProviderMessages(messages),
+		Tools:      toProviderTools(tools),
+		MaxElapsed: c.maxElapsed,
+	})
+	if err != nil {
+		return agents.ChatResponse{}, err
+	}
+
+	chunk := <-chunks
+	if chunk.Err != nil {
+		return agents.ChatResponse{}, chunk.Err
+	}
+	return agents.ChatResponse{
+		Content:   chunk.Text,
+		ToolCalls: toAgentToolCalls(chunk.ToolCalls),
+		Done:      chunk.Done,
+	}, nil
+}
+
+func toProviderMessages(messages []agents.Message) []providers.Message {
+	out := make([]providers.Message, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, providers.Message{
+			Role:       m.Role,
+			Content:    m.Content,
+			ToolCalls:  toProviderToolCalls(m.ToolCalls),
+			ToolCallID: m.ToolCallID,
+		})
+	}
+	return out
+}
+
+func toProviderToolCalls(calls []agents.ToolCall) []providers.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]providers.ToolCall, 0, len(calls))
+	for _, c := range calls {
+		out = append(out, providers.ToolCall{ID: c.ID, Name: c.Name, Arguments: c.Arguments})
+	}
+	return out
+}
+
+func toAgentToolCalls(calls []providers.ToolCall) []agents.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]agents.ToolCall, 0, len(calls))
+	for _, c := range calls {
+		out = append(out, agents.ToolCall{ID: c.ID, Name: c.Name, Arguments: c.Arguments})
+	}
+	return out
+}
+
+func toProviderTools(specs []agents.ToolSpec) []providers.ToolSpec {
+	if len(specs) == 0 {
+		return nil
+	}
+	out := make([]providers.ToolSpec, 0, len(specs))
+	for _, s := range specs {
+		out = append(out, providers.ToolSpec{Name: s.Name, Description: s.Description, Parameters: s.Parameters})
+	}
+	return out
+}
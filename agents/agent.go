@@ -0,0 +1,140 @@
+// Package agents implements a small, provider-agnostic agent abstraction on
+// top of an LLMClient: a named system prompt plus a whitelisted Toolbox of
+// tools the model may invoke via function-calling. The same Agent definition
+// runs unmodified against OpenAI, Anthropic, Groq, Gemini, or Bedrock, since
+// tool-calling is expressed in terms of the provider-neutral types in this
+// package rather than any one SDK's request/response shapes.
+package agents
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Message is a single turn in the conversation sent to the model.
+type Message struct {
+	Role    string // "system", "user", "assistant", or "tool"
+	Content string
+	// ToolCalls holds the tool calls an "assistant" message made, so a
+	// provider can echo them back on the next turn (Anthropic's tool_use
+	// blocks, OpenAI's tool_calls field) instead of losing which call each
+	// later "tool" message is replying to.
+	ToolCalls []ToolCall
+	// ToolCallID identifies which ToolCall a "tool" role message is replying to.
+	ToolCallID string
+}
+
+// ToolCall is a request from the model to invoke one of the agent's tools.
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string // raw JSON arguments, as returned by the provider
+}
+
+// ChatResponse is a single turn returned by an LLMClient: either a final
+// assistant message, or one or more tool calls the agent must satisfy before
+// the model can continue.
+type ChatResponse struct {
+	Content   string
+	ToolCalls []ToolCall
+	Done      bool
+}
+
+// LLMClient is the minimal chat interface an Agent needs from an LLM
+// backend. It is defined here, at the point of use, so that agents stay
+// decoupled from any single provider's SDK.
+type LLMClient interface {
+	Chat(ctx context.Context, messages []Message, tools []ToolSpec) (ChatResponse, error)
+}
+
+// DefaultMaxIterations bounds how many tool-call round trips Agent.Run will
+// make before giving up, so a model stuck calling tools in a loop can't run
+// forever (or rack up unbounded API spend) the way every other model-calling
+// path in podscript is already bounded by a --max-elapsed timeout.
+const DefaultMaxIterations = 25
+
+// Agent pairs a system prompt with a Toolbox of tools the model is allowed
+// to call, and drives the tool-call loop against an LLMClient until the
+// model returns a final message.
+type Agent struct {
+	Name         string
+	SystemPrompt string
+	Client       LLMClient
+	Tools        *Toolbox
+	// Out receives a line for every tool invocation the model makes, so
+	// callers can stream progress to stdout for transparency.
+	Out io.Writer
+	// MaxIterations caps how many tool-call round trips Run will make before
+	// returning an error. Defaults to DefaultMaxIterations if zero.
+	MaxIterations int
+}
+
+// New creates an Agent with the given name, system prompt, client, and
+// toolbox. Out defaults to io.Discard if nil.
+func New(name, systemPrompt string, client LLMClient, tools *Toolbox, out io.Writer) *Agent {
+	if out == nil {
+		out = io.Discard
+	}
+	return &Agent{
+		Name:          name,
+		SystemPrompt:  systemPrompt,
+		Client:        client,
+		Tools:         tools,
+		Out:           out,
+		MaxIterations: DefaultMaxIterations,
+	}
+}
+
+// Run sends userPrompt to the model and loops on tool calls until the model
+// returns a final message, which is returned as the result. Each tool
+// invocation is written to Out as it happens.
+func (a *Agent) Run(ctx context.Context, userPrompt string) (string, error) {
+	messages := []Message{
+		{Role: "system", Content: a.SystemPrompt},
+		{Role: "user", Content: userPrompt},
+	}
+	specs := a.Tools.Specs()
+
+	maxIterations := a.MaxIterations
+	if maxIterations == 0 {
+		maxIterations = DefaultMaxIterations
+	}
+
+	for i := 0; ; i++ {
+		if i >= maxIterations {
+			return "", fmt.Errorf("agent %q: exceeded %d tool-call iterations without a final answer", a.Name, maxIterations)
+		}
+
+		resp, err := a.Client.Chat(ctx, messages, specs)
+		if err != nil {
+			return "", fmt.Errorf("agent %q: chat failed: %w", a.Name, err)
+		}
+
+		if len(resp.ToolCalls) == 0 {
+			return resp.Content, nil
+		}
+
+		messages = append(messages, Message{Role: "assistant", Content: resp.Content, ToolCalls: resp.ToolCalls})
+		for _, call := range resp.ToolCalls {
+			fmt.Fprintf(a.Out, "-> %s(%s)\n", call.Name, call.Arguments)
+			result, err := a.Tools.Call(ctx, call.Name, call.Arguments)
+			if err != nil {
+				result = fmt.Sprintf("error: %v", err)
+			}
+			fmt.Fprintf(a.Out, "<- %s\n", truncate(result, 200))
+			messages = append(messages, Message{
+				Role:       "tool",
+				Content:    result,
+				ToolCallID: call.ID,
+			})
+		}
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "…"
+}
@@ -0,0 +1,58 @@
+package agents
+
+import "fmt"
+
+// Preset names selectable via --agent.
+const (
+	Summarizer = "summarizer"
+	ShowNotes  = "show-notes"
+	Chapterizer = "chapterizer"
+)
+
+var presetPrompts = map[string]string{
+	Summarizer: "You are a podcast summarizer. Given a transcript, produce a concise, " +
+		"well-organized summary covering the main topics, notable claims, and any " +
+		"action items discussed. Use the available tools to pull in show notes or " +
+		"prior transcripts when they would improve accuracy.",
+	ShowNotes: "You write show notes for a podcast episode. Given a transcript, produce " +
+		"a short episode description, a bulleted list of topics with approximate " +
+		"timestamps if present, and any links or resources mentioned. Fetch the " +
+		"episode's show notes page if one is referenced, to cross-check names and links.",
+	Chapterizer: "You split a podcast transcript into chapters. Given a transcript, emit " +
+		"a list of chapter titles with their starting timestamp, suitable for pasting " +
+		"into a YouTube description. Use search_transcript to locate topic boundaries " +
+		"if the transcript is long.",
+}
+
+// presetToolNames lists which built-in tools each preset agent may call.
+var presetToolNames = map[string][]string{
+	Summarizer:  {"fetch_url", "read_file", "modify_file", "search_transcript"},
+	ShowNotes:   {"fetch_url", "read_file", "modify_file", "dir_tree"},
+	Chapterizer: {"read_file", "modify_file", "search_transcript"},
+}
+
+// NewPreset builds one of the built-in agents (Summarizer, ShowNotes,
+// Chapterizer) against client, with its tools rooted at outputDir.
+func NewPreset(agentName string, client LLMClient, outputDir string, out interface {
+	Write([]byte) (int, error)
+}) (*Agent, error) {
+	prompt, ok := presetPrompts[agentName]
+	if !ok {
+		return nil, fmt.Errorf("unknown agent %q: want one of %s, %s, %s", agentName, Summarizer, ShowNotes, Chapterizer)
+	}
+
+	all := map[string]Tool{
+		"fetch_url":         NewFetchURLTool(),
+		"read_file":         &ReadFileTool{Root: outputDir},
+		"modify_file":       &ModifyFileTool{Root: outputDir},
+		"dir_tree":          &DirTreeTool{Root: outputDir},
+		"search_transcript": &SearchTranscriptTool{Root: outputDir},
+	}
+
+	var tools []Tool
+	for _, toolName := range presetToolNames[agentName] {
+		tools = append(tools, all[toolName])
+	}
+
+	return New(agentName, prompt, client, NewToolbox(tools...), out), nil
+}
@@ -0,0 +1,59 @@
+package agents
+
+import (
+	"context"
+	"fmt"
+)
+
+// ToolSpec describes a tool to the model in provider-neutral terms. Each
+// provider package is responsible for translating this into its own
+// function-calling schema (OpenAI tools, Anthropic tool_use, Gemini function
+// declarations, Bedrock Converse tools).
+type ToolSpec struct {
+	Name        string
+	Description string
+	// Parameters is a JSON Schema object describing the tool's arguments.
+	Parameters map[string]any
+}
+
+// Tool is a single callable tool. Call receives the raw JSON arguments the
+// model produced and returns the tool's result as a string.
+type Tool interface {
+	Spec() ToolSpec
+	Call(ctx context.Context, argsJSON string) (string, error)
+}
+
+// Toolbox is a whitelisted set of tools an Agent may call, keyed by name.
+type Toolbox struct {
+	tools map[string]Tool
+}
+
+// NewToolbox builds a Toolbox from the given tools.
+func NewToolbox(tools ...Tool) *Toolbox {
+	tb := &Toolbox{tools: make(map[string]Tool, len(tools))}
+	for _, t := range tools {
+		tb.tools[t.Spec().Name] = t
+	}
+	return tb
+}
+
+// Specs returns the ToolSpec for every tool in the box, for passing to the
+// model as the available function-calling tools.
+func (tb *Toolbox) Specs() []ToolSpec {
+	specs := make([]ToolSpec, 0, len(tb.tools))
+	for _, t := range tb.tools {
+		specs = append(specs, t.Spec())
+	}
+	return specs
+}
+
+// Call invokes the named tool with the given raw JSON arguments. It returns
+// an error if the tool is not in the toolbox, so an agent can never invoke a
+// tool it wasn't explicitly given.
+func (tb *Toolbox) Call(ctx context.Context, name, argsJSON string) (string, error) {
+	t, ok := tb.tools[name]
+	if !ok {
+		return "", fmt.Errorf("tool %q is not available to this agent", name)
+	}
+	return t.Call(ctx, argsJSON)
+}
@@ -0,0 +1,331 @@
+package agents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FetchURLTool retrieves a web page or article, e.g. show notes linked from
+// a video description, so an agent can ground its output in outside text.
+type FetchURLTool struct {
+	Client *http.Client
+}
+
+// NewFetchURLTool returns a FetchURLTool with a sane request timeout, a
+// transport that resolves and IP-checks each dial itself (see
+// safeDialContext), and a redirect check that rejects a disallowed scheme.
+func NewFetchURLTool() *FetchURLTool {
+	return &FetchURLTool{Client: &http.Client{
+		Timeout:   15 * time.Second,
+		Transport: &http.Transport{DialContext: safeDialContext(&net.Dialer{Timeout: 15 * time.Second})},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return validateFetchURLScheme(req.URL)
+		},
+	}}
+}
+
+func (t *FetchURLTool) Spec() ToolSpec {
+	return ToolSpec{
+		Name:        "fetch_url",
+		Description: "Fetch the text content of a web page, such as show notes or a linked article.",
+		Parameters: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"url": map[string]any{"type": "string"}},
+			"required":   []string{"url"},
+		},
+	}
+}
+
+func (t *FetchURLTool) Call(ctx context.Context, argsJSON string) (string, error) {
+	var args struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal([]byte(argsJSON), &args); err != nil {
+		return "", fmt.Errorf("fetch_url: invalid arguments: %w", err)
+	}
+	u, err := url.Parse(args.URL)
+	if err != nil {
+		return "", fmt.Errorf("fetch_url: invalid URL: %w", err)
+	}
+	if err := validateFetchURLScheme(u); err != nil {
+		return "", fmt.Errorf("fetch_url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, args.URL, nil)
+	if err != nil {
+		return "", fmt.Errorf("fetch_url: %w", err)
+	}
+	resp, err := t.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetch_url: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetch_url: %s returned status %d", args.URL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("fetch_url: %w", err)
+	}
+	return string(body), nil
+}
+
+// validateFetchURLScheme rejects any URL whose scheme isn't http/https, or
+// that has no host. IP-range safety is handled separately, by
+// safeDialContext, at the point the connection is actually made.
+func validateFetchURLScheme(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported URL scheme %q: only http and https are allowed", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("URL has no host")
+	}
+	return nil
+}
+
+// safeDialContext wraps dialer so that the hostname in a dial address is
+// resolved, IP-range-checked, and connected to as a single atomic step. A
+// separate "validate the hostname, then let the transport resolve it again
+// to actually connect" approach is vulnerable to DNS rebinding: an
+// attacker-controlled domain can return a public IP for the validation
+// lookup and a private/loopback/link-local address (e.g. the cloud metadata
+// endpoint 169.254.169.254) a moment later for the real connection. Dialing
+// the exact IP that was just checked closes that gap.
+func safeDialContext(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("fetch_url: %w", err)
+		}
+
+		ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("fetch_url: resolving host %q: %w", host, err)
+		}
+
+		var allowed net.IPAddr
+		found := false
+		for _, ip := range ips {
+			if !isDisallowedFetchIP(ip.IP) {
+				allowed = ip
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("fetch_url: host %q has no allowed address", host)
+		}
+
+		return dialer.DialContext(ctx, network, net.JoinHostPort(allowed.IP.String(), port))
+	}
+}
+
+func isDisallowedFetchIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsPrivate() || ip.IsMulticast()
+}
+
+// ReadFileTool lets an agent read a scratch note or prior output from disk.
+type ReadFileTool struct {
+	// Root constrains reads to files under this directory.
+	Root string
+}
+
+func (t *ReadFileTool) Spec() ToolSpec {
+	return ToolSpec{
+		Name:        "read_file",
+		Description: "Read the contents of a file, such as a scratch note or prior transcript output.",
+		Parameters: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"path": map[string]any{"type": "string"}},
+			"required":   []string{"path"},
+		},
+	}
+}
+
+func (t *ReadFileTool) Call(ctx context.Context, argsJSON string) (string, error) {
+	var args struct {
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal([]byte(argsJSON), &args); err != nil {
+		return "", fmt.Errorf("read_file: invalid arguments: %w", err)
+	}
+	full, err := resolveWithinRoot(t.Root, args.Path)
+	if err != nil {
+		return "", fmt.Errorf("read_file: %w", err)
+	}
+	data, err := os.ReadFile(full)
+	if err != nil {
+		return "", fmt.Errorf("read_file: %w", err)
+	}
+	return string(data), nil
+}
+
+// ModifyFileTool lets an agent write or overwrite a scratch note.
+type ModifyFileTool struct {
+	Root string
+}
+
+func (t *ModifyFileTool) Spec() ToolSpec {
+	return ToolSpec{
+		Name:        "modify_file",
+		Description: "Overwrite a file with new content, e.g. to save a scratch note.",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"path":    map[string]any{"type": "string"},
+				"content": map[string]any{"type": "string"},
+			},
+			"required": []string{"path", "content"},
+		},
+	}
+}
+
+func (t *ModifyFileTool) Call(ctx context.Context, argsJSON string) (string, error) {
+	var args struct {
+		Path    string `json:"path"`
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal([]byte(argsJSON), &args); err != nil {
+		return "", fmt.Errorf("modify_file: invalid arguments: %w", err)
+	}
+	full, err := resolveWithinRoot(t.Root, args.Path)
+	if err != nil {
+		return "", fmt.Errorf("modify_file: %w", err)
+	}
+	if err := os.WriteFile(full, []byte(args.Content), 0644); err != nil {
+		return "", fmt.Errorf("modify_file: %w", err)
+	}
+	return fmt.Sprintf("wrote %d bytes to %s", len(args.Content), args.Path), nil
+}
+
+// DirTreeTool lists prior transcript outputs under a directory so an agent
+// can discover what's already been produced before deciding what to do next.
+type DirTreeTool struct {
+	Root string
+}
+
+func (t *DirTreeTool) Spec() ToolSpec {
+	return ToolSpec{
+		Name:        "dir_tree",
+		Description: "List files under a directory of transcript outputs.",
+		Parameters: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"path": map[string]any{"type": "string"}},
+		},
+	}
+}
+
+func (t *DirTreeTool) Call(ctx context.Context, argsJSON string) (string, error) {
+	var args struct {
+		Path string `json:"path"`
+	}
+	_ = json.Unmarshal([]byte(argsJSON), &args)
+
+	root := t.Root
+	if args.Path != "" {
+		var err error
+		root, err = resolveWithinRoot(t.Root, args.Path)
+		if err != nil {
+			return "", fmt.Errorf("dir_tree: %w", err)
+		}
+	}
+
+	var lines []string
+	err := filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(t.Root, p)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		lines = append(lines, rel)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("dir_tree: %w", err)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// SearchTranscriptTool regex-searches prior transcript outputs for a pattern,
+// so an agent can locate a passage without being given the full text.
+type SearchTranscriptTool struct {
+	Root string
+}
+
+func (t *SearchTranscriptTool) Spec() ToolSpec {
+	return ToolSpec{
+		Name:        "search_transcript",
+		Description: "Search prior transcript files under the output directory for lines matching a regular expression.",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"pattern": map[string]any{"type": "string"},
+			},
+			"required": []string{"pattern"},
+		},
+	}
+}
+
+func (t *SearchTranscriptTool) Call(ctx context.Context, argsJSON string) (string, error) {
+	var args struct {
+		Pattern string `json:"pattern"`
+	}
+	if err := json.Unmarshal([]byte(argsJSON), &args); err != nil {
+		return "", fmt.Errorf("search_transcript: invalid arguments: %w", err)
+	}
+	re, err := regexp.Compile(args.Pattern)
+	if err != nil {
+		return "", fmt.Errorf("search_transcript: invalid pattern: %w", err)
+	}
+
+	var matches []string
+	err = filepath.WalkDir(t.Root, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if re.MatchString(line) {
+				rel, _ := filepath.Rel(t.Root, p)
+				matches = append(matches, fmt.Sprintf("%s: %s", rel, line))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("search_transcript: %w", err)
+	}
+	if len(matches) == 0 {
+		return "no matches", nil
+	}
+	return strings.Join(matches, "\n"), nil
+}
+
+// resolveWithinRoot joins root and rel, rejecting any path that escapes root.
+func resolveWithinRoot(root, rel string) (string, error) {
+	full := filepath.Join(root, rel)
+	if !strings.HasPrefix(full, filepath.Clean(root)+string(os.PathSeparator)) && full != filepath.Clean(root) {
+		return "", fmt.Errorf("path %q escapes root %q", rel, root)
+	}
+	return full, nil
+}
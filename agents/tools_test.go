@@ -0,0 +1,42 @@
+package agents
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveWithinRoot(t *testing.T) {
+	root := filepath.FromSlash("/workspace/episode")
+
+	tests := []struct {
+		name    string
+		rel     string
+		want    string
+		wantErr bool
+	}{
+		{"plain file", "transcript.txt", filepath.Join(root, "transcript.txt"), false},
+		{"nested path", "notes/show-notes.md", filepath.Join(root, "notes/show-notes.md"), false},
+		{"root itself", ".", root, false},
+		{"empty rel", "", root, false},
+		{"parent traversal", "../secrets.txt", "", true},
+		{"parent traversal after nested dir", "notes/../../secrets.txt", "", true},
+		{"sibling directory sharing a prefix", "../episode-2/transcript.txt", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveWithinRoot(root, tt.rel)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("resolveWithinRoot(%q, %q) = %q, want error", root, tt.rel, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveWithinRoot(%q, %q) unexpected error: %v", root, tt.rel, err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveWithinRoot(%q, %q) = %q, want %q", root, tt.rel, got, tt.want)
+			}
+		})
+	}
+}
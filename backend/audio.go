@@ -0,0 +1,39 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kkdai/youtube/v2"
+)
+
+// downloadAudio fetches the best audio-only stream for video into a
+// temporary file and returns its path. The caller is responsible for
+// removing it once done.
+func downloadAudio(ctx context.Context, youtubeClient *youtube.Client, video *youtube.Video) (string, error) {
+	formats := video.Formats.WithAudioChannels()
+	if len(formats) == 0 {
+		return "", fmt.Errorf("no audio-only formats available for %s", video.ID)
+	}
+	format := formats[0]
+
+	stream, _, err := youtubeClient.GetStreamContext(ctx, video, &format)
+	if err != nil {
+		return "", fmt.Errorf("failed to open audio stream: %w", err)
+	}
+	defer stream.Close()
+
+	f, err := os.CreateTemp("", "podscript-audio-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp audio file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, stream); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to download audio: %w", err)
+	}
+	return f.Name(), nil
+}
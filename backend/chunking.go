@@ -0,0 +1,157 @@
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+// silenceGapMs is the minimum gap between two caption segments that we treat
+// as a natural speech boundary (e.g. a pause between speakers or topics)
+// worth preferring over a raw word-count cutoff.
+const silenceGapMs = 1000
+
+// overlapWords is how many words of a chunk's tail are carried into the next
+// chunk as read-only prior context, so the model doesn't lose track of
+// what came immediately before.
+const overlapWords = 50
+
+// transcriptChunk is one unit of work handed to the LLM: priorContext is the
+// tail of the previous chunk, included only so the model can stay
+// consistent across the boundary, and newText is the new captions it should
+// actually clean up.
+type transcriptChunk struct {
+	priorContext string
+	newText      string
+}
+
+// chunkTranscriptSegments splits caption segments into chunks of at most
+// maxWordsPerChunk words, preferring to cut at a gap in speech
+// (silenceGapMs or more between segments) once a chunk has accumulated a
+// reasonable amount of text, so chunks tend to land on speaker or topic
+// boundaries instead of mid-sentence. Each chunk then carries the tail of
+// the previous one as read-only prior context.
+func chunkTranscriptSegments(segments []CaptionSegment, maxWordsPerChunk int) []transcriptChunk {
+	var raw []string
+	var wordsInChunk []string
+	prevEndMs := 0
+	havePrev := false
+
+	flush := func() {
+		if len(wordsInChunk) == 0 {
+			return
+		}
+		raw = append(raw, strings.Join(wordsInChunk, " "))
+		wordsInChunk = nil
+	}
+
+	for _, seg := range segments {
+		words := strings.Fields(seg.Text)
+		if len(words) == 0 {
+			continue
+		}
+
+		gapMs := seg.StartMs - prevEndMs
+		atNaturalBoundary := havePrev && gapMs >= silenceGapMs && len(wordsInChunk) > maxWordsPerChunk/2
+		overflowing := len(wordsInChunk)+len(words) > maxWordsPerChunk
+		if atNaturalBoundary || overflowing {
+			flush()
+		}
+
+		wordsInChunk = append(wordsInChunk, words...)
+		prevEndMs = seg.StartMs + seg.Duration
+		havePrev = true
+	}
+	flush()
+
+	chunks := make([]transcriptChunk, len(raw))
+	for i, text := range raw {
+		chunks[i] = transcriptChunk{newText: text}
+		if i > 0 {
+			chunks[i].priorContext = lastWords(raw[i-1], overlapWords)
+		}
+	}
+	return chunks
+}
+
+// TranscriptChunk is the exported counterpart of transcriptChunk, for
+// callers like the jobs store that need to persist chunk boundaries between
+// runs instead of handing Transcribe a whole video in one call.
+type TranscriptChunk struct {
+	PriorContext string
+	NewText      string
+}
+
+// PrepareChunks fetches videoURL's captions (falling back to sttProvider)
+// and splits them into the same overlap-aware chunks Transcribe uses
+// internally, without cleaning any of them up yet.
+func PrepareChunks(ctx context.Context, videoURL string, model LLMModel, sttProvider stt.Provider) ([]TranscriptChunk, error) {
+	segments, err := FetchSegments(ctx, videoURL, sttProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	maxWords := chunkWordsPerModel[model]
+	if maxWords == 0 {
+		maxWords = 3000
+	}
+
+	chunks := chunkTranscriptSegments(segments, maxWords)
+	out := make([]TranscriptChunk, len(chunks))
+	for i, c := range chunks {
+		out[i] = TranscriptChunk{PriorContext: c.priorContext, NewText: c.newText}
+	}
+	return out, nil
+}
+
+// MergeTranscriptText appends next to acc the same way Transcribe
+// reassembles cleaned chunks, trimming any duplicated overlap. It's exported
+// so the jobs store can reassemble a job's chunks the same way after a
+// resume, without re-running the whole pipeline.
+func MergeTranscriptText(acc, next string) string {
+	return mergeOverlap(acc, next)
+}
+
+// lastWords returns the last n whitespace-separated words of s.
+func lastWords(s string, n int) string {
+	words := strings.Fields(s)
+	if len(words) <= n {
+		return s
+	}
+	return strings.Join(words[len(words)-n:], " ")
+}
+
+// mergeOverlap appends next to acc, trimming any leading words of next that
+// duplicate the tail of acc. It finds the longest suffix of acc that
+// matches a prefix of next within the overlap window, which is enough to
+// de-duplicate the cases where the model echoes back a bit of the read-only
+// prior context despite being told not to.
+func mergeOverlap(acc, next string) string {
+	if acc == "" {
+		return next
+	}
+
+	tail := lastWords(acc, overlapWords*2)
+	overlap := longestSuffixPrefixMatch(tail, next)
+	return acc + strings.TrimPrefix(next, overlap)
+}
+
+// longestSuffixPrefixMatch returns the longest string that is both a suffix
+// of a and a prefix of b, checked at word boundaries.
+func longestSuffixPrefixMatch(a, b string) string {
+	aWords := strings.Fields(a)
+	bWords := strings.Fields(b)
+
+	maxLen := len(aWords)
+	if len(bWords) < maxLen {
+		maxLen = len(bWords)
+	}
+
+	for length := maxLen; length > 0; length-- {
+		if strings.Join(aWords[len(aWords)-length:], " ") == strings.Join(bWords[:length], " ") {
+			return strings.Join(bWords[:length], " ") + " "
+		}
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+package backend
+
+import "testing"
+
+func TestLongestSuffixPrefixMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{"no overlap", "the quick brown fox", "jumped over the lazy dog", ""},
+		{"full overlap", "quick brown fox", "quick brown fox jumped", "quick brown fox "},
+		{"partial overlap", "the quick brown fox", "brown fox jumped over", "brown fox "},
+		{"empty b", "the quick brown fox", "", ""},
+		{"empty a", "", "the quick brown fox", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := longestSuffixPrefixMatch(tt.a, tt.b); got != tt.want {
+				t.Errorf("longestSuffixPrefixMatch(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeOverlap(t *testing.T) {
+	tests := []struct {
+		name string
+		acc  string
+		next string
+		want string
+	}{
+		{"empty acc returns next unchanged", "", "hello world", "hello world"},
+		{"no overlap appends in full", "hello world", "goodbye moon", "hello worldgoodbye moon"},
+		{"trims duplicated prefix", "the quick brown fox", "brown fox jumped over", "the quick brown foxjumped over"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeOverlap(tt.acc, tt.next); got != tt.want {
+				t.Errorf("mergeOverlap(%q, %q) = %q, want %q", tt.acc, tt.next, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkTranscriptSegments(t *testing.T) {
+	t.Run("splits on word-count overflow", func(t *testing.T) {
+		segments := []CaptionSegment{
+			{Text: "one two three four five", StartMs: 0, Duration: 1000},
+			{Text: "six seven eight nine ten", StartMs: 1000, Duration: 1000},
+		}
+		chunks := chunkTranscriptSegments(segments, 5)
+		if len(chunks) != 2 {
+			t.Fatalf("got %d chunks, want 2", len(chunks))
+		}
+		if chunks[0].newText != "one two three four five" {
+			t.Errorf("chunk 0 newText = %q", chunks[0].newText)
+		}
+		if chunks[1].newText != "six seven eight nine ten" {
+			t.Errorf("chunk 1 newText = %q", chunks[1].newText)
+		}
+		if chunks[1].priorContext != "one two three four five" {
+			t.Errorf("chunk 1 priorContext = %q, want overlap from chunk 0", chunks[1].priorContext)
+		}
+	})
+
+	t.Run("splits on a natural silence gap before overflow", func(t *testing.T) {
+		// maxWordsPerChunk=6 makes the first segment's 4 words more than
+		// half the limit, and the second segment's 2 words would still fit
+		// (4+2=6), so only the gap before the second segment forces the
+		// split; the word count never overflows.
+		segments := []CaptionSegment{
+			{Text: "one two three four", StartMs: 0, Duration: 1000},
+			{Text: "five six", StartMs: 5000, Duration: 1000},
+		}
+		chunks := chunkTranscriptSegments(segments, 6)
+		if len(chunks) != 2 {
+			t.Fatalf("got %d chunks, want 2 (expected a split at the silence gap)", len(chunks))
+		}
+	})
+
+	t.Run("single chunk when nothing forces a split", func(t *testing.T) {
+		segments := []CaptionSegment{
+			{Text: "one two", StartMs: 0, Duration: 1000},
+			{Text: "three four", StartMs: 1000, Duration: 1000},
+		}
+		chunks := chunkTranscriptSegments(segments, 100)
+		if len(chunks) != 1 {
+			t.Fatalf("got %d chunks, want 1", len(chunks))
+		}
+		if chunks[0].newText != "one two three four" {
+			t.Errorf("newText = %q", chunks[0].newText)
+		}
+	})
+
+	t.Run("empty segments produce no chunks", func(t *testing.T) {
+		if chunks := chunkTranscriptSegments(nil, 100); len(chunks) != 0 {
+			t.Errorf("got %d chunks, want 0", len(chunks))
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samratjha96/podscript/providers"
+)
+
+// SetMaxElapsed overrides how long a single Complete call's own retry-on-429
+// loop may run before giving up. The transcription pipeline uses this to
+// thread --max-elapsed down to the provider instead of relying on each
+// provider's hardcoded default.
+func (c *providerClient) SetMaxElapsed(d time.Duration) {
+	c.maxElapsed = d
+}
+
+// Complete sends a single-turn prompt to the underlying provider and waits
+// for its one, final Chunk. It exists so the transcription pipeline can
+// treat every provider as a plain prompt-in/text-out function while still
+// benefiting from the shared streaming, retry, and usage-accounting
+// machinery each providers.Provider implements.
+func (c *providerClient) Complete(ctx context.Context, prompt string) (CompletionResult, error) {
+	chunks, err := c.provider.Chat(ctx, providers.ChatRequest{
+		Model:      c.model,
+		Messages:   []providers.Message{{Role: "user", Content: prompt}},
+		MaxElapsed: c.maxElapsed,
+	})
+	if err != nil {
+		return CompletionResult{}, err
+	}
+
+	var result CompletionResult
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			return CompletionResult{}, chunk.Err
+		}
+		result.Text += chunk.Text
+		if chunk.Usage != nil {
+			result.Usage = chunk.Usage
+		}
+	}
+	if result.Text == "" {
+		return CompletionResult{}, fmt.Errorf("no content returned from model")
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// OutputFormat selects how raw (pre-cleanup) captions are written to disk.
+type OutputFormat string
+
+const (
+	FormatTXT  OutputFormat = "txt"
+	FormatSRT  OutputFormat = "srt"
+	FormatVTT  OutputFormat = "vtt"
+	FormatJSON OutputFormat = "json"
+)
+
+// FormatSegments renders segments in the given format. Unlike the cleaned
+// transcript, this always reflects the original timing, since an LLM
+// rewrite does not preserve per-word timestamps.
+func FormatSegments(segments []CaptionSegment, format OutputFormat) (string, error) {
+	switch format {
+	case "", FormatTXT:
+		var b strings.Builder
+		for _, s := range segments {
+			b.WriteString(s.Text)
+			b.WriteString("\n")
+		}
+		return b.String(), nil
+	case FormatSRT:
+		return toSRT(segments), nil
+	case FormatVTT:
+		return toVTT(segments), nil
+	case FormatJSON:
+		data, err := json.MarshalIndent(segments, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal segments: %w", err)
+		}
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func toSRT(segments []CaptionSegment) string {
+	var b strings.Builder
+	for i, s := range segments {
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", i+1, srtTimestamp(s.StartMs), srtTimestamp(s.StartMs+s.Duration), s.Text)
+	}
+	return b.String()
+}
+
+func toVTT(segments []CaptionSegment) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for _, s := range segments {
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n", vttTimestamp(s.StartMs), vttTimestamp(s.StartMs+s.Duration), s.Text)
+	}
+	return b.String()
+}
+
+func srtTimestamp(ms int) string {
+	h, m, s, frac := splitMs(ms)
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", h, m, s, frac)
+}
+
+func vttTimestamp(ms int) string {
+	h, m, s, frac := splitMs(ms)
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", h, m, s, frac)
+}
+
+func splitMs(ms int) (hours, minutes, seconds, millis int) {
+	millis = ms % 1000
+	totalSeconds := ms / 1000
+	seconds = totalSeconds % 60
+	totalMinutes := totalSeconds / 60
+	minutes = totalMinutes % 60
+	hours = totalMinutes / 60
+	return
+}
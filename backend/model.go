@@ -0,0 +1,164 @@
+// Package backend owns the transcription pipeline — fetching captions,
+// chunking them, invoking an LLM to clean each chunk, and reassembling the
+// result — on top of the provider-agnostic providers.Provider interface.
+// Credential handling and model routing live here so that both the Kong
+// based YTTCmd and the legacy cobra ytt.Command share one code path instead
+// of each re-implementing OpenAI/Claude plumbing.
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samratjha96/podscript/providers"
+	"github.com/samratjha96/podscript/providers/anthropic"
+	"github.com/samratjha96/podscript/providers/bedrock"
+	"github.com/samratjha96/podscript/providers/gemini"
+	"github.com/samratjha96/podscript/providers/groq"
+	"github.com/samratjha96/podscript/providers/openai"
+)
+
+// LLMModel enumerates every model podscript can drive a transcription with.
+type LLMModel string
+
+const (
+	GPT4o                 LLMModel = "gpt-4o"
+	GPT4oMini             LLMModel = "gpt-4o-mini"
+	Claude35Sonnet        LLMModel = "claude-3-5-sonnet-20240620"
+	Claude35Haiku         LLMModel = "claude-3-5-haiku-20241022"
+	Llama3370b            LLMModel = "llama-3.3-70b-versatile"
+	Llama318b             LLMModel = "llama-3.1-8b-instant"
+	Gemini2Flash          LLMModel = "gemini-2.0-flash"
+	BedrockClaude35Sonnet LLMModel = "anthropic.claude-3-5-sonnet-20240620-v1:0"
+	BedrockClaude35Haiku  LLMModel = "anthropic.claude-3-5-haiku-20241022-v1:0"
+)
+
+// LLMProvider identifies which backend serves a given LLMModel.
+type LLMProvider int
+
+const (
+	OpenAI LLMProvider = iota
+	Claude
+	Groq
+	Gemini
+	Bedrock
+)
+
+// AllModels lists every LLMModel podscript knows how to drive, in the order
+// they're declared. It backs the /v1/models endpoint in server mode.
+func AllModels() []LLMModel {
+	return []LLMModel{
+		GPT4o, GPT4oMini,
+		Claude35Sonnet, Claude35Haiku,
+		Llama3370b, Llama318b,
+		Gemini2Flash,
+		BedrockClaude35Sonnet, BedrockClaude35Haiku,
+	}
+}
+
+// ProviderForModel resolves which LLMProvider serves model.
+func ProviderForModel(model LLMModel) (LLMProvider, error) {
+	switch model {
+	case GPT4o, GPT4oMini:
+		return OpenAI, nil
+	case Claude35Sonnet, Claude35Haiku:
+		return Claude, nil
+	case Llama3370b, Llama318b:
+		return Groq, nil
+	case Gemini2Flash:
+		return Gemini, nil
+	case BedrockClaude35Sonnet, BedrockClaude35Haiku:
+		return Bedrock, nil
+	default:
+		return 0, fmt.Errorf("unsupported model: %s", model)
+	}
+}
+
+// Credentials holds every credential podscript accepts, so NewLLMClient can
+// pick just the ones the chosen provider needs without depending on the CLI
+// command structs that collect them.
+type Credentials struct {
+	OpenAIAPIKey       string
+	AnthropicAPIKey    string
+	GroqAPIKey         string
+	GeminiAPIKey       string
+	AWSRegion          string
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSSessionToken    string
+}
+
+// CompletionResult is the outcome of a single LLMClient.Complete call.
+type CompletionResult struct {
+	Text  string
+	Usage *providers.Usage
+}
+
+// LLMClient is the narrow interface the transcription pipeline needs from a
+// model: a single prompt in, cleaned text out. It is satisfied by
+// providerClient, which adapts the richer, channel-based providers.Provider.
+type LLMClient interface {
+	Complete(ctx context.Context, prompt string) (CompletionResult, error)
+}
+
+// MaxElapsedSetter is implemented by LLMClients that support overriding how
+// long their own retry-on-429 loop may run. Callers type-assert for it
+// rather than having every LLMClient carry the option.
+type MaxElapsedSetter interface {
+	SetMaxElapsed(d time.Duration)
+}
+
+// providerClient adapts a providers.Provider plus a model name to LLMClient.
+type providerClient struct {
+	provider   providers.Provider
+	model      string
+	maxElapsed time.Duration
+}
+
+// NewProvider resolves which providers.Provider backs provider, picking the
+// credential fields it needs from creds. It is exported so callers that need
+// the richer, channel-based providers.Provider directly — such as the agent
+// command's tool-call loop — don't have to maintain their own copy of this
+// provider/credential wiring alongside the transcription pipeline's.
+func NewProvider(provider LLMProvider, model LLMModel, creds Credentials) (providers.Provider, error) {
+	switch provider {
+	case OpenAI:
+		if creds.OpenAIAPIKey == "" {
+			return nil, fmt.Errorf("OpenAI API key required for model %s", model)
+		}
+		return openai.New(creds.OpenAIAPIKey), nil
+	case Claude:
+		if creds.AnthropicAPIKey == "" {
+			return nil, fmt.Errorf("Anthropic API key required for model %s", model)
+		}
+		return anthropic.New(creds.AnthropicAPIKey), nil
+	case Groq:
+		if creds.GroqAPIKey == "" {
+			return nil, fmt.Errorf("Groq API key required for model %s", model)
+		}
+		return groq.New(creds.GroqAPIKey), nil
+	case Gemini:
+		if creds.GeminiAPIKey == "" {
+			return nil, fmt.Errorf("Gemini API key required for model %s", model)
+		}
+		return gemini.New(creds.GeminiAPIKey), nil
+	case Bedrock:
+		if creds.AWSRegion == "" || creds.AWSAccessKeyID == "" || creds.AWSSecretAccessKey == "" || creds.AWSSessionToken == "" {
+			return nil, fmt.Errorf("AWS credentials required for model %s. Run 'podscript configure' to set them up", model)
+		}
+		return bedrock.New(creds.AWSRegion, creds.AWSAccessKeyID, creds.AWSSecretAccessKey, creds.AWSSessionToken), nil
+	default:
+		return nil, fmt.Errorf("unsupported provider for model: %s", model)
+	}
+}
+
+// NewLLMClient resolves which providers.Provider backs model and returns an
+// LLMClient ready to drive the transcription pipeline.
+func NewLLMClient(provider LLMProvider, model LLMModel, creds Credentials) (LLMClient, error) {
+	p, err := NewProvider(provider, model, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &providerClient{provider: p, model: string(model)}, nil
+}
@@ -0,0 +1,235 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kkdai/youtube/v2"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+const userPrompt = `You will be given auto-generated captions from a YouTube video. These may be full captions, or a segment of the full transcript if it is too large. Your task is to transform these captions into a clean, readable transcript. Here are the auto-generated captions:
+
+<captions>
+%s
+</captions>
+
+Follow these steps to create a clean transcript:
+
+1. Correct any spelling errors you encounter. Use your knowledge of common words and context to determine the correct spelling.
+
+2. Add appropriate punctuation throughout the text. This includes commas, periods, question marks, and exclamation points where necessary.
+
+3. Capitalize the first letter of each sentence and proper nouns.
+
+4. Break the text into logical paragraphs. Start a new paragraph when there's a shift in topic or speaker.
+
+5. Remove any unnecessary filler words, repetitions, or false starts.
+
+6. Maintain the original meaning and intent of the transcript. Do not remove any content even if it is unrelated to the main topic.
+
+
+Once you have completed these steps, provide the clean transcript within <transcript> tags. Ensure that the transcript is well-formatted, easy to read,
+and accurately represents the original content of the video. Do not include any additional text in your response.`
+
+const userPromptWithContext = `You will be given auto-generated captions from a YouTube video, split into overlapping segments so you have context across segment boundaries. Here are the auto-generated captions:
+
+<prior_context>
+%s
+</prior_context>
+
+The text in <prior_context> is the tail end of the previous segment, already cleaned up. It is provided only so you don't lose context across the boundary — do not repeat, rephrase, or re-emit any of it in your answer.
+
+<captions>
+%s
+</captions>
+
+Follow these steps to create a clean transcript of the <captions> block only:
+
+1. Correct any spelling errors you encounter. Use your knowledge of common words and context to determine the correct spelling.
+
+2. Add appropriate punctuation throughout the text. This includes commas, periods, question marks, and exclamation points where necessary.
+
+3. Capitalize the first letter of each sentence and proper nouns.
+
+4. Break the text into logical paragraphs. Start a new paragraph when there's a shift in topic or speaker.
+
+5. Remove any unnecessary filler words, repetitions, or false starts.
+
+6. Maintain the original meaning and intent of the transcript. Do not remove any content even if it is unrelated to the main topic.
+
+
+Once you have completed these steps, provide the clean transcript of just the new <captions> region within <transcript> tags, continuing naturally from the prior context. Ensure that the transcript is well-formatted, easy to read, and accurately represents the original content of the video. Do not include any additional text in your response.`
+
+var transcriptRegex = regexp.MustCompile(`(?s)<transcript>(.*?)</transcript>`)
+
+func extractTranscript(input string) string {
+	match := transcriptRegex.FindStringSubmatch(input)
+	if len(match) > 1 {
+		return strings.TrimSpace(match[1])
+	}
+	return ""
+}
+
+// chunkWordsPerModel caps each chunk to roughly what a model's prompt
+// comfortably fits; larger-context models get bigger chunks.
+var chunkWordsPerModel = map[LLMModel]int{
+	GPT4o:                 3000,
+	GPT4oMini:             3000,
+	Claude35Sonnet:        6000,
+	Claude35Haiku:         6000,
+	Llama3370b:            3000,
+	Llama318b:             3000,
+	Gemini2Flash:          6000,
+	BedrockClaude35Sonnet: 6000,
+	BedrockClaude35Haiku:  6000,
+}
+
+// YouTubeTranscriber fetches a YouTube video's auto-generated captions,
+// cleans them up chunk by chunk with an LLMClient, and reassembles the
+// result.
+type YouTubeTranscriber struct {
+	client LLMClient
+	model  LLMModel
+
+	// Concurrency bounds how many chunks are cleaned up in parallel.
+	// Zero means defaultConcurrency.
+	Concurrency int
+	// MaxElapsed bounds the whole Transcribe call, including any
+	// rate-limit backoff across the pool. Zero means no deadline.
+	MaxElapsed time.Duration
+	// STTProvider transcribes the video's audio when YouTube has no
+	// captions for it. Nil means Transcribe fails outright in that case.
+	STTProvider stt.Provider
+}
+
+// NewYouTubeTranscriber builds a transcriber that cleans captions with
+// client, using model to decide how captions are chunked.
+func NewYouTubeTranscriber(client LLMClient, model LLMModel) *YouTubeTranscriber {
+	return &YouTubeTranscriber{client: client, model: model, Concurrency: defaultConcurrency}
+}
+
+// Transcribe downloads video's captions (falling back to STTProvider over
+// the downloaded audio if the video has none), cleans the result up in
+// parallel across up to Concurrency workers, and streams the cleaned
+// transcript to callback in chunk order. callback is invoked with
+// done=true exactly once, after the final chunk.
+func (t *YouTubeTranscriber) Transcribe(ctx context.Context, videoURL string, callback func(text string, done bool) error) error {
+	ctx, cancel := t.BoundContext(ctx)
+	defer cancel()
+
+	segments, err := t.fetchSegments(ctx, videoURL)
+	if err != nil {
+		return err
+	}
+
+	return t.transcribeSegments(ctx, segments, callback)
+}
+
+// TranscribeSegments cleans up already-fetched segments the same way
+// Transcribe does, without re-fetching the video's captions (or audio, for
+// an STT fallback). Callers that already have segments from a prior
+// FetchSegments call, such as a --raw flag that wants to also produce a
+// cleaned transcript, should use this instead of Transcribe to avoid
+// fetching twice.
+func (t *YouTubeTranscriber) TranscribeSegments(ctx context.Context, segments []CaptionSegment, callback func(text string, done bool) error) error {
+	ctx, cancel := t.BoundContext(ctx)
+	defer cancel()
+
+	return t.transcribeSegments(ctx, segments, callback)
+}
+
+// BoundContext wraps ctx with a deadline derived from MaxElapsed and
+// propagates the same deadline to client's own retry-on-429 loop, if it
+// supports MaxElapsedSetter. MaxElapsed itself bounds Transcribe and
+// TranscribeSegments, but it isn't read by ProcessChunks — callers driving
+// job-mode chunk processing directly call this instead of duplicating the
+// wrapping logic. If MaxElapsed is zero, ctx is returned unchanged and the
+// cancel func is a no-op.
+func (t *YouTubeTranscriber) BoundContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.MaxElapsed <= 0 {
+		return ctx, func() {}
+	}
+	if setter, ok := t.client.(MaxElapsedSetter); ok {
+		setter.SetMaxElapsed(t.MaxElapsed)
+	}
+	return context.WithTimeout(ctx, t.MaxElapsed)
+}
+
+// transcribeSegments chunks segments, cleans them up in parallel, and
+// streams the reassembled result to callback. ctx is assumed to already
+// carry any MaxElapsed deadline.
+func (t *YouTubeTranscriber) transcribeSegments(ctx context.Context, segments []CaptionSegment, callback func(text string, done bool) error) error {
+	maxWords := chunkWordsPerModel[t.model]
+	if maxWords == 0 {
+		maxWords = 3000
+	}
+	chunks := chunkTranscriptSegments(segments, maxWords)
+
+	cleaned, err := t.processChunks(ctx, chunks)
+	if err != nil {
+		return err
+	}
+
+	if len(cleaned) == 0 {
+		return callback("", true)
+	}
+
+	// Reassemble in order, trimming any duplication the model introduced
+	// across a chunk boundary despite the prior-context instructions.
+	var acc string
+	for i, text := range cleaned {
+		merged := mergeOverlap(acc, text)
+		addition := strings.TrimPrefix(merged, acc)
+		acc = merged
+		if err := callback(addition, i == len(cleaned)-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchSegments returns video's captions, falling back to STTProvider over
+// its downloaded audio if it has none.
+func (t *YouTubeTranscriber) fetchSegments(ctx context.Context, videoURL string) ([]CaptionSegment, error) {
+	return FetchSegments(ctx, videoURL, t.STTProvider)
+}
+
+// FetchSegments returns videoURL's captions as CaptionSegments, falling back
+// to sttProvider over the video's downloaded audio if it has none. sttProvider
+// may be nil, in which case a video with no captions is an error. It is
+// exported so callers that only want the raw (pre-cleanup) transcript, such
+// as a --raw flag, don't need a full YouTubeTranscriber to get it.
+func FetchSegments(ctx context.Context, videoURL string, sttProvider stt.Provider) ([]CaptionSegment, error) {
+	youtubeClient := youtube.Client{}
+
+	video, err := youtubeClient.GetVideo(videoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video info: %w", err)
+	}
+
+	transcript, err := youtubeClient.GetTranscript(video, "en")
+	if err == nil {
+		return fromYouTubeTranscript(transcript), nil
+	}
+	if sttProvider == nil {
+		return nil, fmt.Errorf("failed to get transcript info: %w", err)
+	}
+
+	audioPath, err := downloadAudio(ctx, &youtubeClient, video)
+	if err != nil {
+		return nil, fmt.Errorf("no captions available, and failed to download audio for speech-to-text: %w", err)
+	}
+	defer os.Remove(audioPath)
+
+	sttSegments, err := sttProvider.Transcribe(ctx, audioPath)
+	if err != nil {
+		return nil, fmt.Errorf("no captions available, and speech-to-text failed: %w", err)
+	}
+	return fromSTTSegments(sttSegments), nil
+}
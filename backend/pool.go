@@ -0,0 +1,160 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/samratjha96/podscript/providers"
+)
+
+const defaultConcurrency = 4
+
+// rateGate lets any worker in the pool pause every other worker when it
+// hits a 429/overloaded response, instead of each worker backing off on its
+// own and continuing to hammer an already-throttled provider.
+type rateGate struct {
+	mu         sync.Mutex
+	pauseUntil time.Time
+}
+
+func (g *rateGate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	until := g.pauseUntil
+	g.mu.Unlock()
+
+	d := time.Until(until)
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pause extends the shared pause window by backoff, with up to 50% jitter,
+// unless another worker already pushed it further out.
+func (g *rateGate) pause(backoff time.Duration) {
+	jittered := backoff + time.Duration(rand.Int63n(int64(backoff)/2+1))
+	until := time.Now().Add(jittered)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if until.After(g.pauseUntil) {
+		g.pauseUntil = until
+	}
+}
+
+// processChunks runs cleanup for each chunk through the pool with bounded
+// concurrency, preserving order via indexed result slots so the caller can
+// reassemble the transcript regardless of which worker finished first.
+func (t *YouTubeTranscriber) processChunks(ctx context.Context, chunks []transcriptChunk) ([]string, error) {
+	concurrency := t.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	results := make([]string, len(chunks))
+	errs := make([]error, len(chunks))
+	gate := &rateGate{}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, chunk := range chunks {
+		i, chunk := i, chunk
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = t.processChunk(ctx, gate, chunk)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to process chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+	}
+	return results, nil
+}
+
+// ChunkResult is the outcome of cleaning up one TranscriptChunk.
+type ChunkResult struct {
+	Text string
+	Err  error
+}
+
+// ProcessChunks cleans up chunks in parallel across up to t.Concurrency
+// workers, the same way Transcribe does internally, but returns every
+// chunk's own result, including its error, instead of failing the whole
+// batch on the first one. This lets callers like the jobs store resume only
+// the chunks that previously failed or never ran, without losing the
+// results of the ones that already succeeded.
+func (t *YouTubeTranscriber) ProcessChunks(ctx context.Context, chunks []TranscriptChunk) []ChunkResult {
+	internal := make([]transcriptChunk, len(chunks))
+	for i, c := range chunks {
+		internal[i] = transcriptChunk{priorContext: c.PriorContext, newText: c.NewText}
+	}
+
+	concurrency := t.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	results := make([]ChunkResult, len(internal))
+	gate := &rateGate{}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, chunk := range internal {
+		i, chunk := i, chunk
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			text, err := t.processChunk(ctx, gate, chunk)
+			results[i] = ChunkResult{Text: text, Err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// processChunk cleans a single chunk, retrying through the shared rateGate
+// whenever the provider reports a rate limit, until ctx is done (bounded by
+// --max-elapsed).
+func (t *YouTubeTranscriber) processChunk(ctx context.Context, gate *rateGate, chunk transcriptChunk) (string, error) {
+	prompt := fmt.Sprintf(userPrompt, chunk.newText)
+	if chunk.priorContext != "" {
+		prompt = fmt.Sprintf(userPromptWithContext, chunk.priorContext, chunk.newText)
+	}
+
+	backoff := time.Second
+	for {
+		if err := gate.wait(ctx); err != nil {
+			return "", err
+		}
+
+		result, err := t.client.Complete(ctx, prompt)
+		if err == nil {
+			return extractTranscript(result.Text), nil
+		}
+		if !providers.IsRateLimited(err) {
+			return "", err
+		}
+
+		gate.pause(backoff)
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package backend
+
+import (
+	"github.com/kkdai/youtube/v2"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+// CaptionSegment is the common timestamped-text shape the chunking and
+// formatting code works with, regardless of whether the words came from
+// YouTube's own captions or a speech-to-text fallback.
+type CaptionSegment struct {
+	Text     string
+	StartMs  int
+	Duration int
+}
+
+func fromYouTubeTranscript(t youtube.VideoTranscript) []CaptionSegment {
+	segments := make([]CaptionSegment, 0, len(t))
+	for _, seg := range t {
+		segments = append(segments, CaptionSegment{Text: seg.Text, StartMs: seg.StartMs, Duration: seg.Duration})
+	}
+	return segments
+}
+
+func fromSTTSegments(in []stt.Segment) []CaptionSegment {
+	segments := make([]CaptionSegment, 0, len(in))
+	for _, seg := range in {
+		segments = append(segments, CaptionSegment{Text: seg.Text, StartMs: seg.StartMs, Duration: seg.Duration})
+	}
+	return segments
+}
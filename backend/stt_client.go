@@ -0,0 +1,53 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/samratjha96/podscript/stt"
+	"github.com/samratjha96/podscript/stt/awstranscribe"
+	"github.com/samratjha96/podscript/stt/groq"
+	"github.com/samratjha96/podscript/stt/whisper"
+	"github.com/samratjha96/podscript/stt/whispercpp"
+)
+
+// STTOptions carries the extra configuration speech-to-text providers need
+// beyond the credentials already in Credentials: where to stage jobs in S3,
+// or where to find a local whisper.cpp binary and model.
+type STTOptions struct {
+	AWSTranscribeBucket string
+	WhisperCppBinary    string
+	WhisperCppModel     string
+}
+
+// NewSTTProvider resolves which stt.Provider backs name.
+func NewSTTProvider(name string, creds Credentials, opts STTOptions) (stt.Provider, error) {
+	switch name {
+	case "whisper":
+		if creds.OpenAIAPIKey == "" {
+			return nil, fmt.Errorf("OpenAI API key required for --stt-provider whisper")
+		}
+		return whisper.New(creds.OpenAIAPIKey), nil
+	case "groq":
+		if creds.GroqAPIKey == "" {
+			return nil, fmt.Errorf("Groq API key required for --stt-provider groq")
+		}
+		return groq.New(creds.GroqAPIKey), nil
+	case "aws-transcribe":
+		if creds.AWSRegion == "" || creds.AWSAccessKeyID == "" || creds.AWSSecretAccessKey == "" || creds.AWSSessionToken == "" {
+			return nil, fmt.Errorf("AWS credentials required for --stt-provider aws-transcribe")
+		}
+		if opts.AWSTranscribeBucket == "" {
+			return nil, fmt.Errorf("an S3 bucket is required for --stt-provider aws-transcribe")
+		}
+		return awstranscribe.New(creds.AWSRegion, creds.AWSAccessKeyID, creds.AWSSecretAccessKey, creds.AWSSessionToken, opts.AWSTranscribeBucket), nil
+	case "whispercpp":
+		if opts.WhisperCppBinary == "" || opts.WhisperCppModel == "" {
+			return nil, fmt.Errorf("--whispercpp-binary and --whispercpp-model are required for --stt-provider whispercpp")
+		}
+		return whispercpp.New(opts.WhisperCppBinary, opts.WhisperCppModel), nil
+	case "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported --stt-provider: %s", name)
+	}
+}
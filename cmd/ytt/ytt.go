@@ -1,62 +1,22 @@
 package ytt
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"os"
 	"path"
-	"regexp"
 	"strings"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
-	"github.com/kkdai/youtube/v2"
-	"github.com/liushuangls/go-anthropic/v2"
-	"github.com/sashabaranov/go-openai"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/thediveo/enumflag/v2"
-)
-
-const (
-	userPrompt = `You will be given auto-generated captions from a YouTube video. These may be full captions, or a segment of the full transcript if it is too large. Your task is to transform these captions into a clean, readable transcript. Here are the auto-generated captions:
-
-<captions>
-%s
-</captions>
-
-Follow these steps to create a clean transcript:
-
-1. Correct any spelling errors you encounter. Use your knowledge of common words and context to determine the correct spelling.
-
-2. Add appropriate punctuation throughout the text. This includes commas, periods, question marks, and exclamation points where necessary.
-
-3. Capitalize the first letter of each sentence and proper nouns.
-
-4. Break the text into logical paragraphs. Start a new paragraph when there's a shift in topic or speaker.
-
-5. Remove any unnecessary filler words, repetitions, or false starts.
-
-6. Maintain the original meaning and intent of the transcript. Do not remove any content even if it is unrelated to the main topic.
 
-
-Once you have completed these steps, provide the clean transcript within <transcript> tags. Ensure that the transcript is well-formatted, easy to read, 
-and accurately represents the original content of the video. Do not include any additional text in your response.`
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/stt"
 )
 
-var transcriptRegex = regexp.MustCompile(`(?s)<transcript>(.*?)</transcript>`)
-
-func extractTranscript(input string) string {
-	match := transcriptRegex.FindStringSubmatch(input)
-	if len(match) > 1 {
-		return strings.TrimSpace(match[1])
-	}
-	return ""
-}
-
 type Model enumflag.Flag
 
 // Enumeration of allowed ColorMode values.
@@ -71,120 +31,6 @@ var modelMap = map[Model][]string{
 	Model(ModelClaude):  {"claude"},
 }
 
-func callChatGPTAPIWithBackoff(client *openai.Client, text string) (string, error) {
-
-	req := openai.ChatCompletionRequest{
-		Model: openai.GPT4o,
-		Messages: []openai.ChatCompletionMessage{
-			{
-				Role:    openai.ChatMessageRoleUser,
-				Content: fmt.Sprintf(userPrompt, text),
-			},
-		},
-	}
-
-	backOff := backoff.NewExponentialBackOff()
-	backOff.MaxElapsedTime = 10 * time.Minute
-
-	var resp openai.ChatCompletionResponse
-
-	err := backoff.Retry(func() (err error) {
-		resp, err = client.CreateChatCompletion(context.Background(), req)
-		if err != nil {
-			// Check if the error is a 429 (Too Many Requests) error
-			var openAIError *openai.APIError
-			if errors.As(err, &openAIError) {
-				if openAIError.HTTPStatusCode == http.StatusTooManyRequests {
-					// This is a 429 error, so we'll retry
-					fmt.Printf("%v\n", err)
-					fmt.Println("Retrying…")
-					return err
-				}
-			}
-			// For any other error, we'll stop retrying
-			return backoff.Permanent(err)
-		}
-		return nil
-	}, backOff)
-
-	if err != nil {
-		return "", err
-	}
-	if len(resp.Choices) == 0 {
-		return "", fmt.Errorf("no choices returned from API")
-	}
-
-	// TODO: Log this as debug output
-	// fmt.Printf("Usage: %+v\n", resp.Usage)
-	return resp.Choices[0].Message.Content, nil
-}
-
-func callClaudeAPIWithBackoff(client *anthropic.Client, text string) (string, error) {
-	req := &anthropic.MessagesRequest{
-		Model: anthropic.ModelClaude3Dot5Sonnet20240620,
-		Messages: []anthropic.Message{
-			anthropic.NewUserTextMessage(fmt.Sprintf(userPrompt, text)),
-		},
-		MaxTokens: 8192,
-	}
-
-	backOff := backoff.NewExponentialBackOff()
-	backOff.MaxElapsedTime = 10 * time.Minute
-
-	var resp anthropic.MessagesResponse
-
-	err := backoff.Retry(func() (err error) {
-		resp, err = client.CreateMessages(context.Background(), *req)
-		if err != nil {
-			var anthropicAPIError *anthropic.APIError
-			if errors.As(err, &anthropicAPIError) {
-				if anthropicAPIError.IsRateLimitErr() || anthropicAPIError.IsOverloadedErr() {
-					fmt.Printf("%v\n", err)
-					fmt.Println("Retrying…")
-					return err
-				}
-			}
-			// For any other error, we'll stop retrying
-			return backoff.Permanent(err)
-		}
-		return nil
-	}, backOff)
-
-	if err != nil {
-		return "", err
-	}
-
-	// TODO: Log this as debug output
-	fmt.Printf("Usage: %+v\n", resp.Usage)
-	return resp.GetFirstContentText(), nil
-}
-
-func chunkTranscript(transcript string, maxWordsPerChunk int) []string {
-	// Split the transcript into chunks
-	var chunks []string
-	scanner := bufio.NewScanner(strings.NewReader(transcript))
-	scanner.Split(bufio.ScanWords)
-
-	var chunkBuilder strings.Builder
-	wordCount := 0
-
-	for scanner.Scan() {
-		word := scanner.Text()
-		chunkBuilder.WriteString(word + " ")
-		wordCount++
-		if wordCount >= maxWordsPerChunk {
-			chunks = append(chunks, chunkBuilder.String())
-			chunkBuilder.Reset()
-			wordCount = 0
-		}
-	}
-	if chunkBuilder.Len() > 0 {
-		chunks = append(chunks, chunkBuilder.String())
-	}
-	return chunks
-
-}
-
 var Command = &cobra.Command{
 	Use:   "ytt <youtube_url>",
 	Short: "Generate cleaned up transcript from YouTube autogenerated captions using ChatGPT",
@@ -219,83 +65,59 @@ var Command = &cobra.Command{
 			filenameSuffix = fmt.Sprintf("%s_%s", timestamp, suffix)
 		}
 
-		// Extract Transcript
-		youtubeClient := youtube.Client{}
+		format := backend.OutputFormat(cmd.Flags().Lookup("format").Value.String())
+		rawTranscriptFilename := path.Join(folder, fmt.Sprintf("raw_transcript_%s.%s", filenameSuffix, format))
+		cleanedTranscriptFilename := path.Join(folder, fmt.Sprintf("cleaned_transcript_%s.txt", filenameSuffix))
 
-		video, err := youtubeClient.GetVideo(args[0])
+		sttProvider, err := sttProviderFromFlags(cmd)
 		if err != nil {
-			return fmt.Errorf("failed to get video info: %w", err)
+			return err
 		}
 
-		transcript, err := youtubeClient.GetTranscript(video, "en")
-		if err != nil {
-			return fmt.Errorf("failed to get transcript info: %w", err)
+		// Stop if only raw transcript required: run the raw fetch ourselves,
+		// since backend.YouTubeTranscriber always cleans with an LLM.
+		if raw {
+			_, err := fetchAndWriteRawTranscript(args[0], rawTranscriptFilename, format, sttProvider)
+			return err
 		}
 
-		var transcriptTxt string
-		for _, tr := range transcript {
-			transcriptTxt += tr.Text + "\n"
+		llmModel := backend.GPT4o
+		provider := backend.OpenAI
+		creds := backend.Credentials{OpenAIAPIKey: openaiApiKey}
+		if model == "claude" {
+			llmModel = backend.Claude35Sonnet
+			provider = backend.Claude
+			creds = backend.Credentials{AnthropicAPIKey: anthropicApiKey}
 		}
 
-		rawTranscriptFilename := path.Join(folder, fmt.Sprintf("raw_transcript_%s.txt", filenameSuffix))
-		if err = os.WriteFile(rawTranscriptFilename, []byte(transcriptTxt), 0644); err != nil {
-			return fmt.Errorf("failed to write raw transcript: %w", err)
+		segments, err := fetchAndWriteRawTranscript(args[0], rawTranscriptFilename, format, sttProvider)
+		if err != nil {
+			return err
 		}
 		fmt.Printf("wrote raw autogenerated captions to %s\n", rawTranscriptFilename)
 
-		// Stop if only raw transcript required
-		if raw {
-			return nil
-		}
-
-		var maxWordsPerChunk int
-		if model == "chatgpt" {
-			maxWordsPerChunk = 3000
-		} else if model == "claude" {
-			maxWordsPerChunk = 6000
+		client, err := backend.NewLLMClient(provider, llmModel, creds)
+		if err != nil {
+			return err
 		}
-		// Chunk and Send to OpenAI
-		chunks := chunkTranscript(transcriptTxt, maxWordsPerChunk)
-		// First chunk used as context
 
-		var (
-			openAPIClient   *openai.Client
-			claudeAPIClient *anthropic.Client
-		)
-
-		if model == "chatgpt" {
-			openAPIClient = openai.NewClient(openaiApiKey)
-		} else {
-			claudeAPIClient = anthropic.NewClient(
-				anthropicApiKey,
-				anthropic.WithBetaVersion(anthropic.BetaMaxTokens35Sonnet20240715))
-		}
-		caller := func(chunk string) (string, error) {
-			if model == "chatgpt" {
-				return callChatGPTAPIWithBackoff(openAPIClient, chunk)
-			} else if model == "claude" {
-				return callClaudeAPIWithBackoff(claudeAPIClient, chunk)
-			}
-			panic("should never get here")
-		}
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		maxElapsed, _ := cmd.Flags().GetDuration("max-elapsed")
 
 		var cleanedTranscript strings.Builder
-		for i, chunk := range chunks {
-			cleanedChunk, err := caller(chunk)
-			if err != nil {
-				return fmt.Errorf("failed to process chunk: %w", err)
-			}
-			cleanedChunk = extractTranscript(cleanedChunk)
-			cleanedTranscript.WriteString(cleanedChunk)
-			fmt.Printf("transcribed part %d/%d…\n", i+1, len(chunks))
-		}
-
+		transcriber := backend.NewYouTubeTranscriber(client, llmModel)
+		transcriber.Concurrency = concurrency
+		transcriber.MaxElapsed = maxElapsed
+		transcriber.STTProvider = sttProvider
+		err = transcriber.TranscribeSegments(context.Background(), segments, func(text string, done bool) error {
+			cleanedTranscript.WriteString(text)
+			return nil
+		})
 		if err != nil {
 			return fmt.Errorf("failed to process chunk: %w", err)
 		}
 
-		cleanedTranscriptFilename := path.Join(folder, fmt.Sprintf("cleaned_transcript_%s.txt", filenameSuffix))
-		if err = os.WriteFile(cleanedTranscriptFilename, []byte(cleanedTranscript.String()), 0644); err != nil {
+		if err := os.WriteFile(cleanedTranscriptFilename, []byte(cleanedTranscript.String()), 0644); err != nil {
 			return fmt.Errorf("failed to write cleaned transcript: %w", err)
 		}
 		fmt.Printf("wrote cleaned up transcripts to %s\n", cleanedTranscriptFilename)
@@ -303,9 +125,64 @@ var Command = &cobra.Command{
 	},
 }
 
+// sttProviderFromFlags resolves the --stt-provider flag (and its supporting
+// options) into an stt.Provider, or nil if none was requested.
+func sttProviderFromFlags(cmd *cobra.Command) (stt.Provider, error) {
+	name, _ := cmd.Flags().GetString("stt-provider")
+	bucket, _ := cmd.Flags().GetString("aws-transcribe-bucket")
+	whispercppBinary, _ := cmd.Flags().GetString("whispercpp-binary")
+	whispercppModel, _ := cmd.Flags().GetString("whispercpp-model")
+
+	creds := backend.Credentials{
+		OpenAIAPIKey:       viper.GetString("openai_api_key"),
+		AnthropicAPIKey:    viper.GetString("anthropic_api_key"),
+		GroqAPIKey:         viper.GetString("groq_api_key"),
+		GeminiAPIKey:       viper.GetString("gemini_api_key"),
+		AWSRegion:          viper.GetString("aws_region"),
+		AWSAccessKeyID:     viper.GetString("aws_access_key_id"),
+		AWSSecretAccessKey: viper.GetString("aws_secret_access_key"),
+		AWSSessionToken:    viper.GetString("aws_session_token"),
+	}
+
+	return backend.NewSTTProvider(name, creds, backend.STTOptions{
+		AWSTranscribeBucket: bucket,
+		WhisperCppBinary:    whispercppBinary,
+		WhisperCppModel:     whispercppModel,
+	})
+}
+
+// fetchAndWriteRawTranscript downloads a video's auto-generated captions
+// (falling back to sttProvider if it has none), writes them to filename in
+// the given format untouched by any LLM cleanup, and returns the fetched
+// segments so a caller that also wants a cleaned transcript doesn't have to
+// fetch the video a second time.
+func fetchAndWriteRawTranscript(videoURL, filename string, format backend.OutputFormat, sttProvider stt.Provider) ([]backend.CaptionSegment, error) {
+	segments, err := backend.FetchSegments(context.Background(), videoURL, sttProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	formatted, err := backend.FormatSegments(segments, format)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filename, []byte(formatted), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write raw transcript: %w", err)
+	}
+	return segments, nil
+}
+
 func init() {
 	Command.Flags().StringP("path", "p", "", "save raw and cleaned up transcripts to path")
 	Command.Flags().StringP("suffix", "s", "", "append suffix to filenames")
 	Command.Flags().BoolP("raw", "r", false, "download raw transcript, don't cleanup using LLM")
 	Command.Flags().VarP(enumflag.New(new(Model), "model", modelMap, enumflag.EnumCaseInsensitive), "model", "m", "use specified model: can be 'chatgpt' (default if omitted) or 'claude'")
+	Command.Flags().IntP("concurrency", "c", 4, "number of chunks to clean up in parallel")
+	Command.Flags().Duration("max-elapsed", 10*time.Minute, "give up on the whole transcription after this long")
+	Command.Flags().String("format", "txt", "raw transcript format: txt, srt, vtt, or json")
+	Command.Flags().String("stt-provider", "", "speech-to-text provider to fall back to when the video has no captions: whisper, groq, aws-transcribe, or whispercpp")
+	Command.Flags().String("aws-transcribe-bucket", "", "S3 bucket to stage jobs in for --stt-provider aws-transcribe")
+	Command.Flags().String("whispercpp-binary", "", "path to the whisper.cpp binary for --stt-provider whispercpp")
+	Command.Flags().String("whispercpp-model", "", "path to the whisper.cpp model for --stt-provider whispercpp")
 }
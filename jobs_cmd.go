@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/jobstore"
+)
+
+// JobsCmd groups subcommands for podscript's persistent transcription job
+// store, so a long run interrupted by a rate limit or network blip can be
+// resumed instead of rerun — and its captions redownloaded — from scratch.
+type JobsCmd struct {
+	List   JobsListCmd   `cmd:"" help:"List all transcription jobs"`
+	Show   JobsShowCmd   `cmd:"" help:"Show a job's status and chunks"`
+	Resume JobsResumeCmd `cmd:"" help:"Resume a job's pending or failed chunks, optionally against a different model"`
+	Rm     JobsRmCmd     `cmd:"" help:"Delete a job"`
+}
+
+func openJobStore() (*jobstore.Store, error) {
+	path, err := jobstore.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return jobstore.Open(path)
+}
+
+// JobsListCmd lists every job recorded in the store.
+type JobsListCmd struct{}
+
+func (cmd *JobsListCmd) Run() error {
+	store, err := openJobStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	jobs, err := store.ListJobs(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		fmt.Printf("%d\t%s\t%s\t%s\n", j.ID, j.Status, j.Model, j.VideoURL)
+	}
+	return nil
+}
+
+// JobsShowCmd prints a job's status alongside each of its chunks.
+type JobsShowCmd struct {
+	ID int64 `arg:"" help:"Job ID"`
+}
+
+func (cmd *JobsShowCmd) Run() error {
+	store, err := openJobStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	job, chunks, err := store.GetJob(context.Background(), cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("job %d: %s (%s, %s)\n", job.ID, job.VideoURL, job.Model, job.Status)
+	for _, c := range chunks {
+		fmt.Printf("  chunk %d: %s\n", c.Index, c.Status)
+		if c.Error != "" {
+			fmt.Printf("    error: %s\n", c.Error)
+		}
+	}
+	return nil
+}
+
+// JobsRmCmd deletes a job and its chunks.
+type JobsRmCmd struct {
+	ID int64 `arg:"" help:"Job ID"`
+}
+
+func (cmd *JobsRmCmd) Run() error {
+	store, err := openJobStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	return store.DeleteJob(context.Background(), cmd.ID)
+}
+
+// JobsResumeCmd (re-)cleans a job's pending or failed chunks. Passing
+// --model branches into a new job sharing the original's raw captions
+// instead of mutating it in place, mirroring conversation branching in chat
+// CLIs.
+type JobsResumeCmd struct {
+	ID                 int64            `arg:"" help:"Job ID to resume"`
+	Model              backend.LLMModel `help:"Re-clean the job with a different model, branching into a new job whose chunks all start pending, so every chunk is re-cleaned, not only the original's pending/failed ones"`
+	OpenAIAPIKey       string           `name:"openai-api-key" help:"OpenAI API key" env:"OPENAI_API_KEY" hidden:""`
+	AnthropicAPIKey    string           `help:"Anthropic API key" env:"ANTHROPIC_API_KEY" hidden:""`
+	GroqAPIKey         string           `help:"Groq API key" env:"GROQ_API_KEY" hidden:""`
+	GeminiAPIKey       string           `help:"Gemini API key" env:"GEMINI_API_KEY" hidden:""`
+	AWSRegion          string           `help:"AWS Region" env:"AWS_REGION" hidden:""`
+	AWSAccessKeyID     string           `help:"AWS Access Key ID" env:"AWS_ACCESS_KEY_ID" hidden:""`
+	AWSSecretAccessKey string           `help:"AWS Secret Access Key ID" env:"AWS_SECRET_ACCESS_KEY" hidden:""`
+	AWSSessionToken    string           `help:"AWS Session Token" env:"AWS_SESSION_TOKEN" hidden:""`
+	Concurrency        int              `help:"Number of chunks to clean up in parallel" default:"4" short:"c"`
+	MaxElapsed         time.Duration    `name:"max-elapsed" help:"Give up on the whole resume after this long" default:"10m"`
+	Output             string           `help:"Path to write the reassembled transcript to (default: stdout)" short:"o"`
+}
+
+func (cmd *JobsResumeCmd) credentials() backend.Credentials {
+	return backend.Credentials{
+		OpenAIAPIKey:       cmd.OpenAIAPIKey,
+		AnthropicAPIKey:    cmd.AnthropicAPIKey,
+		GroqAPIKey:         cmd.GroqAPIKey,
+		GeminiAPIKey:       cmd.GeminiAPIKey,
+		AWSRegion:          cmd.AWSRegion,
+		AWSAccessKeyID:     cmd.AWSAccessKeyID,
+		AWSSecretAccessKey: cmd.AWSSecretAccessKey,
+		AWSSessionToken:    cmd.AWSSessionToken,
+	}
+}
+
+func (cmd *JobsResumeCmd) Run() error {
+	store, err := openJobStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	ctx := context.Background()
+	targetID := cmd.ID
+
+	if cmd.Model != "" {
+		branched, err := store.BranchJob(ctx, cmd.ID, string(cmd.Model))
+		if err != nil {
+			return err
+		}
+		targetID = branched.ID
+		fmt.Printf("branched job %d into job %d with model %s\n", cmd.ID, targetID, cmd.Model)
+	}
+
+	job, chunks, err := store.GetJob(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	model := cmd.Model
+	if model == "" {
+		model = backend.LLMModel(job.Model)
+	}
+
+	var pending []jobstore.Chunk
+	for _, c := range chunks {
+		if c.Status != jobstore.StatusDone {
+			pending = append(pending, c)
+		}
+	}
+
+	if len(pending) == 0 {
+		fmt.Printf("job %d already complete\n", targetID)
+	} else {
+		if err := cmd.cleanPending(ctx, store, targetID, model, pending); err != nil {
+			return err
+		}
+	}
+
+	_, chunks, err = store.GetJob(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if cmd.Output != "" {
+		f, err := os.Create(cmd.Output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	var acc string
+	for _, c := range chunks {
+		acc = backend.MergeTranscriptText(acc, c.CleanedText)
+	}
+	fmt.Fprintln(out, acc)
+	return nil
+}
+
+// cleanPending runs pending through the LLM and records each outcome,
+// leaving already-done chunks untouched.
+func (cmd *JobsResumeCmd) cleanPending(ctx context.Context, store *jobstore.Store, jobID int64, model backend.LLMModel, pending []jobstore.Chunk) error {
+	provider, err := backend.ProviderForModel(model)
+	if err != nil {
+		return err
+	}
+
+	client, err := backend.NewLLMClient(provider, model, cmd.credentials())
+	if err != nil {
+		return err
+	}
+
+	transcriber := backend.NewYouTubeTranscriber(client, model)
+	transcriber.Concurrency = cmd.Concurrency
+	transcriber.MaxElapsed = cmd.MaxElapsed
+
+	ctx, cancel := transcriber.BoundContext(ctx)
+	defer cancel()
+
+	toClean := make([]backend.TranscriptChunk, len(pending))
+	for i, c := range pending {
+		toClean[i] = backend.TranscriptChunk{PriorContext: c.PriorContext, NewText: c.NewText}
+	}
+
+	results := transcriber.ProcessChunks(ctx, toClean)
+
+	failed := 0
+	for i, res := range results {
+		if err := store.UpdateChunk(ctx, jobID, pending[i].Index, res.Text, res.Err); err != nil {
+			return err
+		}
+		if res.Err != nil {
+			failed++
+		}
+	}
+
+	status := jobstore.StatusDone
+	if failed > 0 {
+		status = jobstore.StatusFailed
+	}
+	if err := store.SetJobStatus(ctx, jobID, status); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d chunks still failing; rerun jobs resume to retry", failed, len(pending))
+	}
+	return nil
+}
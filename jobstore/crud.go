@@ -0,0 +1,139 @@
+package jobstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/samratjha96/podscript/backend"
+)
+
+// CreateJob inserts a new job and its chunks, all pending, and returns it.
+func (s *Store) CreateJob(ctx context.Context, videoURL, model string, chunks []backend.TranscriptChunk) (*Job, error) {
+	now := time.Now()
+	res, err := s.db.ExecContext(ctx,
+		`INSERT INTO jobs (video_url, model, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		videoURL, model, StatusPending, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("jobstore: creating job: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("jobstore: creating job: %w", err)
+	}
+
+	for i, c := range chunks {
+		if _, err := s.db.ExecContext(ctx,
+			`INSERT INTO chunks (job_id, idx, prior_context, new_text, status) VALUES (?, ?, ?, ?, ?)`,
+			id, i, c.PriorContext, c.NewText, StatusPending); err != nil {
+			return nil, fmt.Errorf("jobstore: creating chunk %d: %w", i, err)
+		}
+	}
+
+	return &Job{ID: id, VideoURL: videoURL, Model: model, Status: StatusPending, CreatedAt: now, UpdatedAt: now}, nil
+}
+
+// ListJobs returns every job, most recently created first.
+func (s *Store) ListJobs(ctx context.Context) ([]Job, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, video_url, model, status, created_at, updated_at FROM jobs ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("jobstore: listing jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []Job
+	for rows.Next() {
+		var j Job
+		if err := rows.Scan(&j.ID, &j.VideoURL, &j.Model, &j.Status, &j.CreatedAt, &j.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("jobstore: scanning job: %w", err)
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, rows.Err()
+}
+
+// GetJob returns a job and its chunks in index order.
+func (s *Store) GetJob(ctx context.Context, id int64) (*Job, []Chunk, error) {
+	j := Job{ID: id}
+	err := s.db.QueryRowContext(ctx,
+		`SELECT video_url, model, status, created_at, updated_at FROM jobs WHERE id = ?`, id,
+	).Scan(&j.VideoURL, &j.Model, &j.Status, &j.CreatedAt, &j.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil, fmt.Errorf("jobstore: job %d not found", id)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("jobstore: getting job %d: %w", id, err)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT idx, prior_context, new_text, cleaned_text, status, error FROM chunks WHERE job_id = ? ORDER BY idx`, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("jobstore: listing chunks for job %d: %w", id, err)
+	}
+	defer rows.Close()
+
+	var chunks []Chunk
+	for rows.Next() {
+		c := Chunk{JobID: id}
+		if err := rows.Scan(&c.Index, &c.PriorContext, &c.NewText, &c.CleanedText, &c.Status, &c.Error); err != nil {
+			return nil, nil, fmt.Errorf("jobstore: scanning chunk: %w", err)
+		}
+		chunks = append(chunks, c)
+	}
+	return &j, chunks, rows.Err()
+}
+
+// UpdateChunk records the outcome of (re)cleaning chunk index of job id.
+// A nil chunkErr marks the chunk done; any other value marks it failed.
+func (s *Store) UpdateChunk(ctx context.Context, jobID int64, index int, cleanedText string, chunkErr error) error {
+	status, errMsg := StatusDone, ""
+	if chunkErr != nil {
+		status, errMsg = StatusFailed, chunkErr.Error()
+	}
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE chunks SET cleaned_text = ?, status = ?, error = ? WHERE job_id = ? AND idx = ?`,
+		cleanedText, status, errMsg, jobID, index)
+	if err != nil {
+		return fmt.Errorf("jobstore: updating chunk %d of job %d: %w", index, jobID, err)
+	}
+	return nil
+}
+
+// SetJobStatus updates a job's overall status, e.g. once every chunk is done
+// or a resume attempt still leaves some failing.
+func (s *Store) SetJobStatus(ctx context.Context, id int64, status Status) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE jobs SET status = ?, updated_at = ? WHERE id = ?`, status, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("jobstore: updating job %d status: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteJob removes a job and its chunks.
+func (s *Store) DeleteJob(ctx context.Context, id int64) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM chunks WHERE job_id = ?`, id); err != nil {
+		return fmt.Errorf("jobstore: deleting chunks of job %d: %w", id, err)
+	}
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM jobs WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("jobstore: deleting job %d: %w", id, err)
+	}
+	return nil
+}
+
+// BranchJob creates a new job for the same video and raw chunks as source,
+// but with a different model, so it can be re-cleaned without redownloading
+// or re-chunking captions.
+func (s *Store) BranchJob(ctx context.Context, sourceID int64, model string) (*Job, error) {
+	source, chunks, err := s.GetJob(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]backend.TranscriptChunk, len(chunks))
+	for i, c := range chunks {
+		raw[i] = backend.TranscriptChunk{PriorContext: c.PriorContext, NewText: c.NewText}
+	}
+	return s.CreateJob(ctx, source.VideoURL, model, raw)
+}
@@ -0,0 +1,108 @@
+// Package jobstore persists transcription jobs in SQLite so a long
+// transcript that fails partway through (rate limits, network) can be
+// resumed instead of rerun from scratch, and so a finished job can be
+// "branched" — re-cleaned with a different model without re-fetching or
+// re-chunking captions.
+package jobstore
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// Status is the lifecycle state of a Job or Chunk.
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+// Job is one transcription run: a video, the model cleaning it up, and the
+// chunks that make up its captions.
+type Job struct {
+	ID        int64
+	VideoURL  string
+	Model     string
+	Status    Status
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Chunk is one TranscriptChunk belonging to a Job, plus its cleanup state.
+type Chunk struct {
+	JobID        int64
+	Index        int
+	PriorContext string
+	NewText      string
+	CleanedText  string
+	Status       Status
+	Error        string
+}
+
+// Store wraps the SQLite database backing the jobs workspace.
+type Store struct {
+	db *sql.DB
+}
+
+// DefaultPath returns ~/.podscript/jobs.db, creating the ~/.podscript
+// directory if it doesn't exist yet.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("jobstore: resolving home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".podscript")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("jobstore: creating %s: %w", dir, err)
+	}
+	return filepath.Join(dir, "jobs.db"), nil
+}
+
+// Open opens (creating if necessary) the SQLite database at path and
+// ensures its schema exists.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("jobstore: opening %s: %w", path, err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("jobstore: creating schema: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS jobs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	video_url TEXT NOT NULL,
+	model TEXT NOT NULL,
+	status TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS chunks (
+	job_id INTEGER NOT NULL,
+	idx INTEGER NOT NULL,
+	prior_context TEXT NOT NULL,
+	new_text TEXT NOT NULL,
+	cleaned_text TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL,
+	error TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (job_id, idx)
+);
+`
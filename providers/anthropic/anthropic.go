@@ -0,0 +1,156 @@
+// Package anthropic implements providers.Provider against the Anthropic
+// Messages API.
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	anthropicsdk "github.com/liushuangls/go-anthropic/v2"
+
+	"github.com/samratjha96/podscript/providers"
+)
+
+// Client adapts the Anthropic SDK to providers.Provider.
+type Client struct {
+	sdk *anthropicsdk.Client
+}
+
+// New builds a Client from an API key.
+func New(apiKey string) *Client {
+	return &Client{sdk: anthropicsdk.NewClient(apiKey, anthropicsdk.WithBetaVersion(anthropicsdk.BetaMaxTokens35Sonnet20240715))}
+}
+
+func (c *Client) Chat(ctx context.Context, req providers.ChatRequest) (<-chan providers.Chunk, error) {
+	out := make(chan providers.Chunk, 1)
+
+	maxTokens := req.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = 8192
+	}
+
+	system, msgs := toMessages(req.Messages)
+	sdkReq := anthropicsdk.MessagesRequest{
+		Model:     anthropicsdk.Model(req.Model),
+		Messages:  msgs,
+		System:    system,
+		Tools:     toTools(req.Tools),
+		MaxTokens: maxTokens,
+	}
+
+	go func() {
+		defer close(out)
+
+		maxElapsed := req.MaxElapsed
+		if maxElapsed == 0 {
+			maxElapsed = 10 * time.Minute
+		}
+		backOff := backoff.NewExponentialBackOff()
+		backOff.MaxElapsedTime = maxElapsed
+
+		rateLimited := false
+		var resp anthropicsdk.MessagesResponse
+		err := backoff.Retry(func() (err error) {
+			resp, err = c.sdk.CreateMessages(ctx, sdkReq)
+			if err != nil {
+				var apiErr *anthropicsdk.APIError
+				if errors.As(err, &apiErr) && (apiErr.IsRateLimitErr() || apiErr.IsOverloadedErr()) {
+					rateLimited = true
+					return err
+				}
+				rateLimited = false
+				return backoff.Permanent(err)
+			}
+			return nil
+		}, backOff)
+		if err != nil {
+			if rateLimited {
+				err = &providers.RateLimitError{Err: err}
+			}
+			out <- providers.Chunk{Err: err, Done: true}
+			return
+		}
+
+		var text string
+		var calls []providers.ToolCall
+		for _, block := range resp.Content {
+			switch block.Type {
+			case anthropicsdk.MessagesContentTypeText:
+				text += block.GetText()
+			case anthropicsdk.MessagesContentTypeToolUse:
+				argsJSON, _ := json.Marshal(block.MessageContentToolUse.Input)
+				calls = append(calls, providers.ToolCall{
+					ID:        block.MessageContentToolUse.ID,
+					Name:      block.MessageContentToolUse.Name,
+					Arguments: string(argsJSON),
+				})
+			}
+		}
+
+		out <- providers.Chunk{
+			Text:      text,
+			ToolCalls: calls,
+			Usage: &providers.Usage{
+				PromptTokens:     resp.Usage.InputTokens,
+				CompletionTokens: resp.Usage.OutputTokens,
+			},
+			Done: true,
+		}
+	}()
+
+	return out, nil
+}
+
+// toMessages splits out the system prompt, since Anthropic takes it as a
+// top-level request field, and preserves role and tool-call structure for
+// every other message: an assistant turn that called tools is echoed back
+// as a tool_use block per call, and the tool results that answer it are
+// bundled into a single following user message as tool_result blocks keyed
+// by ToolCallID, as the Messages API requires.
+func toMessages(messages []providers.Message) (system string, out []anthropicsdk.Message) {
+	for i := 0; i < len(messages); i++ {
+		m := messages[i]
+		switch m.Role {
+		case "system":
+			system = m.Content
+		case "assistant":
+			var content []anthropicsdk.MessageContent
+			if m.Content != "" {
+				content = append(content, anthropicsdk.NewTextMessageContent(m.Content))
+			}
+			for _, tc := range m.ToolCalls {
+				content = append(content, anthropicsdk.NewToolUseMessageContent(tc.ID, tc.Name, json.RawMessage(tc.Arguments)))
+			}
+			out = append(out, anthropicsdk.Message{Role: anthropicsdk.RoleAssistant, Content: content})
+		case "tool":
+			var content []anthropicsdk.MessageContent
+			for i < len(messages) && messages[i].Role == "tool" {
+				content = append(content, anthropicsdk.NewToolResultMessageContent(messages[i].ToolCallID, messages[i].Content, false))
+				i++
+			}
+			i--
+			out = append(out, anthropicsdk.Message{Role: anthropicsdk.RoleUser, Content: content})
+		default:
+			out = append(out, anthropicsdk.NewUserTextMessage(m.Content))
+		}
+	}
+	return system, out
+}
+
+func toTools(specs []providers.ToolSpec) []anthropicsdk.ToolDefinition {
+	if len(specs) == 0 {
+		return nil
+	}
+	out := make([]anthropicsdk.ToolDefinition, 0, len(specs))
+	for _, s := range specs {
+		out = append(out, anthropicsdk.ToolDefinition{
+			Name:        s.Name,
+			Description: s.Description,
+			InputSchema: s.Parameters,
+		})
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+// Package bedrock implements providers.Provider against AWS Bedrock's
+// Converse API, used to reach Claude models through AWS credentials instead
+// of an Anthropic API key.
+package bedrock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	brTypes "github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	smithydocument "github.com/aws/smithy-go/document"
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/samratjha96/podscript/providers"
+)
+
+// Client adapts the Bedrock Converse API to providers.Provider.
+type Client struct {
+	sdk *bedrockruntime.Client
+}
+
+// New builds a Client from explicit AWS credentials, matching the fields
+// podscript already accepts for Bedrock-backed models.
+func New(region, accessKeyID, secretAccessKey, sessionToken string) *Client {
+	cfg := aws.Config{
+		Region:      region,
+		Credentials: credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, sessionToken),
+	}
+	return &Client{sdk: bedrockruntime.NewFromConfig(cfg)}
+}
+
+func (c *Client) Chat(ctx context.Context, req providers.ChatRequest) (<-chan providers.Chunk, error) {
+	out := make(chan providers.Chunk, 1)
+
+	messages, system := toMessages(req.Messages)
+	toolConfig := toToolConfig(req.Tools)
+
+	go func() {
+		defer close(out)
+
+		maxElapsed := req.MaxElapsed
+		if maxElapsed == 0 {
+			maxElapsed = 10 * time.Minute
+		}
+		backOff := backoff.NewExponentialBackOff()
+		backOff.MaxElapsedTime = maxElapsed
+
+		rateLimited := false
+		var resp *bedrockruntime.ConverseOutput
+		err := backoff.Retry(func() (err error) {
+			resp, err = c.sdk.Converse(ctx, &bedrockruntime.ConverseInput{
+				ModelId:    aws.String(req.Model),
+				Messages:   messages,
+				System:     system,
+				ToolConfig: toolConfig,
+			})
+			if err != nil {
+				if isRateLimited(err) {
+					rateLimited = true
+					return err
+				}
+				rateLimited = false
+				return backoff.Permanent(err)
+			}
+			return nil
+		}, backOff)
+		if err != nil {
+			if rateLimited {
+				err = &providers.RateLimitError{Err: err}
+			}
+			out <- providers.Chunk{Err: fmt.Errorf("bedrock: %w", err), Done: true}
+			return
+		}
+
+		output, ok := resp.Output.(*brTypes.ConverseOutputMemberMessage)
+		if !ok {
+			out <- providers.Chunk{Err: fmt.Errorf("bedrock: unexpected response shape"), Done: true}
+			return
+		}
+
+		var text string
+		var calls []providers.ToolCall
+		for _, block := range output.Value.Content {
+			switch b := block.(type) {
+			case *brTypes.ContentBlockMemberText:
+				text += b.Value
+			case *brTypes.ContentBlockMemberToolUse:
+				var input map[string]any
+				_ = b.Value.Input.UnmarshalSmithyDocument(&input)
+				argsJSON, _ := json.Marshal(input)
+				calls = append(calls, providers.ToolCall{
+					ID:        aws.ToString(b.Value.ToolUseId),
+					Name:      aws.ToString(b.Value.Name),
+					Arguments: string(argsJSON),
+				})
+			}
+		}
+
+		chunk := providers.Chunk{Text: text, ToolCalls: calls, Done: true}
+		if resp.Usage != nil {
+			chunk.Usage = &providers.Usage{
+				PromptTokens:     int(aws.ToInt32(resp.Usage.InputTokens)),
+				CompletionTokens: int(aws.ToInt32(resp.Usage.OutputTokens)),
+			}
+		}
+		out <- chunk
+	}()
+
+	return out, nil
+}
+
+// toMessages splits out the system prompt, since Bedrock's Converse API
+// takes it as a top-level request field, and preserves tool-call structure
+// for every other message: an assistant turn that called tools carries a
+// ToolUseBlock per call, and a "tool" message answering one is sent back as
+// a user-role ToolResultBlock keyed by ToolCallID.
+func toMessages(messages []providers.Message) (out []brTypes.Message, system []brTypes.SystemContentBlock) {
+	for _, m := range messages {
+		switch m.Role {
+		case "system":
+			system = append(system, &brTypes.SystemContentBlockMemberText{Value: m.Content})
+		case "assistant":
+			var blocks []brTypes.ContentBlock
+			if m.Content != "" {
+				blocks = append(blocks, &brTypes.ContentBlockMemberText{Value: m.Content})
+			}
+			for _, tc := range m.ToolCalls {
+				var input map[string]any
+				_ = json.Unmarshal([]byte(tc.Arguments), &input)
+				blocks = append(blocks, &brTypes.ContentBlockMemberToolUse{
+					Value: brTypes.ToolUseBlock{
+						ToolUseId: aws.String(tc.ID),
+						Name:      aws.String(tc.Name),
+						Input:     smithydocument.NewLazyDocument(input),
+					},
+				})
+			}
+			out = append(out, brTypes.Message{Role: brTypes.ConversationRoleAssistant, Content: blocks})
+		case "tool":
+			out = append(out, brTypes.Message{
+				Role: brTypes.ConversationRoleUser,
+				Content: []brTypes.ContentBlock{&brTypes.ContentBlockMemberToolResult{
+					Value: brTypes.ToolResultBlock{
+						ToolUseId: aws.String(m.ToolCallID),
+						Content:   []brTypes.ToolResultContentBlock{&brTypes.ToolResultContentBlockMemberText{Value: m.Content}},
+					},
+				}},
+			})
+		default:
+			out = append(out, brTypes.Message{
+				Role:    brTypes.ConversationRoleUser,
+				Content: []brTypes.ContentBlock{&brTypes.ContentBlockMemberText{Value: m.Content}},
+			})
+		}
+	}
+	return out, system
+}
+
+func toToolConfig(specs []providers.ToolSpec) *brTypes.ToolConfiguration {
+	if len(specs) == 0 {
+		return nil
+	}
+	toolConfig := &brTypes.ToolConfiguration{}
+	for _, s := range specs {
+		toolConfig.Tools = append(toolConfig.Tools, &brTypes.ToolMemberToolSpec{
+			Value: brTypes.ToolSpecification{
+				Name:        aws.String(s.Name),
+				Description: aws.String(s.Description),
+				InputSchema: &brTypes.ToolInputSchemaMemberJson{Value: smithydocument.NewLazyDocument(s.Parameters)},
+			},
+		})
+	}
+	return toolConfig
+}
+
+// isRateLimited reports whether err is Bedrock's ThrottlingException, so the
+// caller can back off and retry instead of failing the chunk outright.
+func isRateLimited(err error) bool {
+	var throttled *brTypes.ThrottlingException
+	return errors.As(err, &throttled)
+}
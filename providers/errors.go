@@ -0,0 +1,20 @@
+package providers
+
+import "errors"
+
+// RateLimitError wraps an error that persisted after a provider's own retry
+// loop gave up because the API kept responding 429/overloaded. Callers that
+// want to back off across a whole worker pool, rather than per-request, can
+// distinguish this from other failures with IsRateLimited.
+type RateLimitError struct {
+	Err error
+}
+
+func (e *RateLimitError) Error() string { return e.Err.Error() }
+func (e *RateLimitError) Unwrap() error { return e.Err }
+
+// IsRateLimited reports whether err is, or wraps, a RateLimitError.
+func IsRateLimited(err error) bool {
+	var rle *RateLimitError
+	return errors.As(err, &rle)
+}
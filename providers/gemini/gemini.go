@@ -0,0 +1,245 @@
+// Package gemini implements providers.Provider against Google's Gemini API.
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	genai "github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/samratjha96/podscript/providers"
+)
+
+// Client adapts the Gemini SDK to providers.Provider.
+type Client struct {
+	apiKey string
+}
+
+// New builds a Client from an API key. The underlying genai client is
+// created per request since it does not expose a lightweight reuse path.
+func New(apiKey string) *Client {
+	return &Client{apiKey: apiKey}
+}
+
+func (c *Client) Chat(ctx context.Context, req providers.ChatRequest) (<-chan providers.Chunk, error) {
+	client, err := genai.NewClient(ctx, option.WithAPIKey(c.apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("gemini: %w", err)
+	}
+
+	out := make(chan providers.Chunk, 1)
+
+	go func() {
+		defer close(out)
+		defer client.Close()
+
+		model := client.GenerativeModel(req.Model)
+		for _, t := range req.Tools {
+			model.Tools = append(model.Tools, &genai.Tool{
+				FunctionDeclarations: []*genai.FunctionDeclaration{{
+					Name:        t.Name,
+					Description: t.Description,
+					Parameters:  toSchema(t.Parameters),
+				}},
+			})
+		}
+		if sys := systemPrompt(req.Messages); sys != "" {
+			model.SystemInstruction = &genai.Content{Parts: []genai.Part{genai.Text(sys)}}
+		}
+
+		contents := toContents(req.Messages)
+		if len(contents) == 0 {
+			out <- providers.Chunk{Err: fmt.Errorf("gemini: no messages to send"), Done: true}
+			return
+		}
+		last := contents[len(contents)-1]
+
+		session := model.StartChat()
+		session.History = contents[:len(contents)-1]
+
+		maxElapsed := req.MaxElapsed
+		if maxElapsed == 0 {
+			maxElapsed = 10 * time.Minute
+		}
+		backOff := backoff.NewExponentialBackOff()
+		backOff.MaxElapsedTime = maxElapsed
+
+		rateLimited := false
+		var resp *genai.GenerateContentResponse
+		err = backoff.Retry(func() (err error) {
+			resp, err = session.SendMessage(ctx, last.Parts...)
+			if err != nil {
+				if isRateLimited(err) {
+					rateLimited = true
+					return err
+				}
+				rateLimited = false
+				return backoff.Permanent(err)
+			}
+			return nil
+		}, backOff)
+		if err != nil {
+			if rateLimited {
+				err = &providers.RateLimitError{Err: err}
+			}
+			out <- providers.Chunk{Err: fmt.Errorf("gemini: %w", err), Done: true}
+			return
+		}
+		if len(resp.Candidates) == 0 {
+			out <- providers.Chunk{Err: fmt.Errorf("gemini: no candidates returned"), Done: true}
+			return
+		}
+
+		var text string
+		var calls []providers.ToolCall
+		for _, part := range resp.Candidates[0].Content.Parts {
+			switch p := part.(type) {
+			case genai.Text:
+				text += string(p)
+			case genai.FunctionCall:
+				args, _ := marshalArgs(p.Args)
+				// Gemini gives function calls no ID of their own; derive one
+				// that is unique within the conversation, since the history
+				// grows by at least one message every turn.
+				id := fmt.Sprintf("%s-%d-%d", p.Name, len(req.Messages), len(calls))
+				calls = append(calls, providers.ToolCall{ID: id, Name: p.Name, Arguments: args})
+			}
+		}
+
+		out <- providers.Chunk{Text: text, ToolCalls: calls, Done: true}
+	}()
+
+	return out, nil
+}
+
+// systemPrompt pulls out the conversation's system message, since Gemini
+// takes it as a model-level SystemInstruction rather than a message in the
+// turn sequence.
+func systemPrompt(messages []providers.Message) string {
+	for _, m := range messages {
+		if m.Role == "system" {
+			return m.Content
+		}
+	}
+	return ""
+}
+
+// toContents converts the whole conversation to Gemini's Content history,
+// including tool calls (as model-role FunctionCall parts) and tool results
+// (as function-role FunctionResponse parts), so a multi-turn function-calling
+// loop round-trips correctly instead of only ever sending the latest message.
+func toContents(messages []providers.Message) []*genai.Content {
+	var out []*genai.Content
+	for _, m := range messages {
+		switch m.Role {
+		case "system":
+			continue
+		case "assistant":
+			var parts []genai.Part
+			if m.Content != "" {
+				parts = append(parts, genai.Text(m.Content))
+			}
+			for _, tc := range m.ToolCalls {
+				var args map[string]any
+				_ = json.Unmarshal([]byte(tc.Arguments), &args)
+				parts = append(parts, genai.FunctionCall{Name: tc.Name, Args: args})
+			}
+			out = append(out, &genai.Content{Role: "model", Parts: parts})
+		case "tool":
+			out = append(out, &genai.Content{Role: "function", Parts: []genai.Part{genai.FunctionResponse{
+				Name:     toolNameForCall(messages, m.ToolCallID),
+				Response: map[string]any{"result": m.Content},
+			}}})
+		default:
+			out = append(out, &genai.Content{Role: "user", Parts: []genai.Part{genai.Text(m.Content)}})
+		}
+	}
+	return out
+}
+
+// toolNameForCall looks up the tool name a prior assistant turn gave the
+// call identified by toolCallID, since Gemini's FunctionResponse must name
+// the function it's answering but our "tool" messages only carry the ID.
+func toolNameForCall(messages []providers.Message, toolCallID string) string {
+	for _, m := range messages {
+		for _, tc := range m.ToolCalls {
+			if tc.ID == toolCallID {
+				return tc.Name
+			}
+		}
+	}
+	return ""
+}
+
+// toSchema converts a tool's JSON Schema parameters (as used by
+// providers.ToolSpec) into Gemini's Schema type.
+func toSchema(params map[string]any) *genai.Schema {
+	if params == nil {
+		return nil
+	}
+	schema := &genai.Schema{Type: schemaType(params["type"])}
+	if props, ok := params["properties"].(map[string]any); ok {
+		schema.Properties = make(map[string]*genai.Schema, len(props))
+		for name, raw := range props {
+			if propMap, ok := raw.(map[string]any); ok {
+				schema.Properties[name] = toSchema(propMap)
+			}
+		}
+	}
+	switch req := params["required"].(type) {
+	case []string:
+		schema.Required = req
+	case []any:
+		for _, r := range req {
+			if s, ok := r.(string); ok {
+				schema.Required = append(schema.Required, s)
+			}
+		}
+	}
+	return schema
+}
+
+func schemaType(t any) genai.Type {
+	switch t {
+	case "object":
+		return genai.TypeObject
+	case "string":
+		return genai.TypeString
+	case "number":
+		return genai.TypeNumber
+	case "integer":
+		return genai.TypeInteger
+	case "boolean":
+		return genai.TypeBoolean
+	case "array":
+		return genai.TypeArray
+	default:
+		return genai.TypeString
+	}
+}
+
+// isRateLimited reports whether err represents a Gemini rate limit or quota
+// error, whether surfaced as a REST googleapi.Error (429) or a gRPC status
+// (ResourceExhausted), so the caller can back off and retry instead of
+// failing the chunk outright.
+func isRateLimited(err error) bool {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusTooManyRequests {
+		return true
+	}
+	return status.Code(err) == codes.ResourceExhausted
+}
+
+func marshalArgs(args map[string]any) (string, error) {
+	b, err := json.Marshal(args)
+	return string(b), err
+}
@@ -0,0 +1,17 @@
+// Package groq implements providers.Provider against Groq's OpenAI-compatible
+// chat completions API.
+package groq
+
+import (
+	"github.com/samratjha96/podscript/providers/openai"
+)
+
+const baseURL = "https://api.groq.com/openai/v1"
+
+// Client reuses the OpenAI provider against Groq's OpenAI-compatible endpoint.
+type Client = openai.Client
+
+// New builds a Client from a Groq API key.
+func New(apiKey string) *Client {
+	return openai.NewWithBaseURL(apiKey, baseURL)
+}
@@ -0,0 +1,147 @@
+// Package openai implements providers.Provider against the OpenAI chat
+// completions API.
+package openai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	openaisdk "github.com/sashabaranov/go-openai"
+
+	"github.com/samratjha96/podscript/providers"
+)
+
+// Client adapts the OpenAI SDK to providers.Provider.
+type Client struct {
+	sdk *openaisdk.Client
+}
+
+// New builds a Client from an API key.
+func New(apiKey string) *Client {
+	return &Client{sdk: openaisdk.NewClient(apiKey)}
+}
+
+// NewWithBaseURL builds a Client against an OpenAI-compatible endpoint other
+// than api.openai.com, e.g. Groq.
+func NewWithBaseURL(apiKey, baseURL string) *Client {
+	cfg := openaisdk.DefaultConfig(apiKey)
+	cfg.BaseURL = baseURL
+	return &Client{sdk: openaisdk.NewClientWithConfig(cfg)}
+}
+
+func (c *Client) Chat(ctx context.Context, req providers.ChatRequest) (<-chan providers.Chunk, error) {
+	out := make(chan providers.Chunk, 1)
+
+	sdkReq := openaisdk.ChatCompletionRequest{
+		Model:    req.Model,
+		Messages: toMessages(req.Messages),
+		Tools:    toTools(req.Tools),
+	}
+
+	go func() {
+		defer close(out)
+
+		maxElapsed := req.MaxElapsed
+		if maxElapsed == 0 {
+			maxElapsed = 10 * time.Minute
+		}
+		backOff := backoff.NewExponentialBackOff()
+		backOff.MaxElapsedTime = maxElapsed
+
+		rateLimited := false
+		var resp openaisdk.ChatCompletionResponse
+		err := backoff.Retry(func() (err error) {
+			resp, err = c.sdk.CreateChatCompletion(ctx, sdkReq)
+			if err != nil {
+				var apiErr *openaisdk.APIError
+				if errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusTooManyRequests {
+					rateLimited = true
+					return err
+				}
+				rateLimited = false
+				return backoff.Permanent(err)
+			}
+			return nil
+		}, backOff)
+		if err != nil {
+			if rateLimited {
+				err = &providers.RateLimitError{Err: err}
+			}
+			out <- providers.Chunk{Err: err, Done: true}
+			return
+		}
+		if len(resp.Choices) == 0 {
+			out <- providers.Chunk{Err: io.ErrUnexpectedEOF, Done: true}
+			return
+		}
+
+		msg := resp.Choices[0].Message
+		out <- providers.Chunk{
+			Text:      msg.Content,
+			ToolCalls: toToolCalls(msg.ToolCalls),
+			Usage: &providers.Usage{
+				PromptTokens:     resp.Usage.PromptTokens,
+				CompletionTokens: resp.Usage.CompletionTokens,
+			},
+			Done: true,
+		}
+	}()
+
+	return out, nil
+}
+
+func toMessages(messages []providers.Message) []openaisdk.ChatCompletionMessage {
+	out := make([]openaisdk.ChatCompletionMessage, 0, len(messages))
+	for _, m := range messages {
+		msg := openaisdk.ChatCompletionMessage{
+			Role:       m.Role,
+			Content:    m.Content,
+			ToolCallID: m.ToolCallID,
+		}
+		for _, tc := range m.ToolCalls {
+			msg.ToolCalls = append(msg.ToolCalls, openaisdk.ToolCall{
+				ID:   tc.ID,
+				Type: openaisdk.ToolTypeFunction,
+				Function: openaisdk.FunctionCall{
+					Name:      tc.Name,
+					Arguments: tc.Arguments,
+				},
+			})
+		}
+		out = append(out, msg)
+	}
+	return out
+}
+
+func toTools(specs []providers.ToolSpec) []openaisdk.Tool {
+	if len(specs) == 0 {
+		return nil
+	}
+	out := make([]openaisdk.Tool, 0, len(specs))
+	for _, s := range specs {
+		out = append(out, openaisdk.Tool{
+			Type: openaisdk.ToolTypeFunction,
+			Function: &openaisdk.FunctionDefinition{
+				Name:        s.Name,
+				Description: s.Description,
+				Parameters:  s.Parameters,
+			},
+		})
+	}
+	return out
+}
+
+func toToolCalls(calls []openaisdk.ToolCall) []providers.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]providers.ToolCall, 0, len(calls))
+	for _, c := range calls {
+		out = append(out, providers.ToolCall{ID: c.ID, Name: c.Function.Name, Arguments: c.Function.Arguments})
+	}
+	return out
+}
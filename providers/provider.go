@@ -0,0 +1,72 @@
+// Package providers defines the provider-agnostic contract that every LLM
+// backend (OpenAI, Anthropic, Groq, Gemini, Bedrock) implements. Credential
+// handling and model routing live in the backend package; each provider
+// package here only knows how to turn a ChatRequest into a stream of Chunks
+// for one specific API.
+package providers
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a single turn in a chat request.
+type Message struct {
+	Role    string // "system", "user", "assistant", or "tool"
+	Content string
+	// ToolCalls holds the tool calls an "assistant" message made, so a
+	// provider can echo them back on the next turn (Anthropic's tool_use
+	// blocks, OpenAI's tool_calls field, etc.) instead of losing which call
+	// each later "tool" message is replying to.
+	ToolCalls []ToolCall
+	// ToolCallID identifies which ToolCall a "tool" role message is replying to.
+	ToolCallID string
+}
+
+// ToolSpec describes a callable tool in provider-neutral terms.
+type ToolSpec struct {
+	Name        string
+	Description string
+	Parameters  map[string]any
+}
+
+// ToolCall is a request from the model to invoke a tool.
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
+
+// ChatRequest is a provider-neutral chat completion request.
+type ChatRequest struct {
+	Model     string
+	Messages  []Message
+	Tools     []ToolSpec
+	MaxTokens int
+	// MaxElapsed bounds how long a provider's own retry-on-429 loop may run
+	// before giving up. Zero means the provider picks its own default.
+	MaxElapsed time.Duration
+}
+
+// Usage reports token accounting for a completed request.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Chunk is one unit of a streamed Chat response. A final chunk has Done set
+// and, for non-streaming providers, carries the complete response in Text.
+type Chunk struct {
+	Text      string
+	ToolCalls []ToolCall
+	Usage     *Usage
+	Done      bool
+	Err       error
+}
+
+// Provider is the single interface every LLM backend implements. Chat
+// returns a channel of Chunks so callers can consume tokens, tool calls, and
+// usage counts uniformly regardless of whether the underlying API streams.
+type Provider interface {
+	Chat(ctx context.Context, req ChatRequest) (<-chan Chunk, error)
+}
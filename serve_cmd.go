@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/server"
+)
+
+// ServeCmd runs an HTTP server exposing an OpenAI-compatible transcription
+// API (POST /v1/audio/transcriptions, POST /v1/transcripts/youtube, GET
+// /v1/models), so podscript can be driven by any OpenAI-SDK client or wired
+// into pipelines (n8n, Flowise, chat UIs) instead of shelled out to.
+type ServeCmd struct {
+	OpenAIAPIKey        string `name:"openai-api-key" help:"OpenAI API key" env:"OPENAI_API_KEY" hidden:""`
+	AnthropicAPIKey     string `help:"Anthropic API key" env:"ANTHROPIC_API_KEY" hidden:""`
+	GroqAPIKey          string `help:"Groq API key" env:"GROQ_API_KEY" hidden:""`
+	GeminiAPIKey        string `help:"Gemini API key" env:"GEMINI_API_KEY" hidden:""`
+	AWSRegion           string `help:"AWS Region" env:"AWS_REGION" hidden:""`
+	AWSAccessKeyID      string `help:"AWS Access Key ID" env:"AWS_ACCESS_KEY_ID" hidden:""`
+	AWSSecretAccessKey  string `help:"AWS Secret Access Key ID" env:"AWS_SECRET_ACCESS_KEY" hidden:""`
+	AWSSessionToken     string `help:"AWS Session Token" env:"AWS_SESSION_TOKEN" hidden:""`
+	STTProvider         string `name:"stt-provider" help:"Speech-to-text provider backing /v1/audio/transcriptions and caption-less YouTube videos: whisper, groq, aws-transcribe, or whispercpp"`
+	AWSTranscribeBucket string `name:"aws-transcribe-bucket" help:"S3 bucket to stage jobs in for --stt-provider aws-transcribe"`
+	WhisperCppBinary    string `name:"whispercpp-binary" help:"Path to the whisper.cpp binary for --stt-provider whispercpp"`
+	WhisperCppModel     string `name:"whispercpp-model" help:"Path to the whisper.cpp model for --stt-provider whispercpp"`
+	APIKey              string `name:"api-key" help:"Require this value as a Bearer token on every request" env:"PODSCRIPT_SERVER_API_KEY"`
+	Port                int    `help:"Port to listen on" default:"8080" short:"p"`
+}
+
+func (cmd *ServeCmd) Run() error {
+	srv, err := server.New(server.Options{
+		APIKey: cmd.APIKey,
+		Credentials: backend.Credentials{
+			OpenAIAPIKey:       cmd.OpenAIAPIKey,
+			AnthropicAPIKey:    cmd.AnthropicAPIKey,
+			GroqAPIKey:         cmd.GroqAPIKey,
+			GeminiAPIKey:       cmd.GeminiAPIKey,
+			AWSRegion:          cmd.AWSRegion,
+			AWSAccessKeyID:     cmd.AWSAccessKeyID,
+			AWSSecretAccessKey: cmd.AWSSecretAccessKey,
+			AWSSessionToken:    cmd.AWSSessionToken,
+		},
+		STTProviderName: cmd.STTProvider,
+		STTOptions: backend.STTOptions{
+			AWSTranscribeBucket: cmd.AWSTranscribeBucket,
+			WhisperCppBinary:    cmd.WhisperCppBinary,
+			WhisperCppModel:     cmd.WhisperCppModel,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf(":%d", cmd.Port)
+	fmt.Printf("podscript serve listening on %s\n", addr)
+	return http.ListenAndServe(addr, srv.Handler())
+}
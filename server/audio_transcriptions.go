@@ -0,0 +1,97 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/stt"
+)
+
+const maxUploadBytes = 200 << 20 // 200MB, generous for an hour or two of audio
+
+// handleAudioTranscriptions serves POST /v1/audio/transcriptions in the
+// shape the OpenAI Audio API expects: a multipart "file" field holding the
+// audio to transcribe, or (as a podscript extension) a "url" field pointing
+// at a YouTube video. Unlike /v1/transcripts/youtube, this never runs LLM
+// cleanup — it returns the raw speech-to-text output, matching what OpenAI's
+// own endpoint returns.
+func (s *Server) handleAudioTranscriptions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "POST required")
+		return
+	}
+
+	if err := r.ParseMultipartForm(maxUploadBytes); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid multipart form: "+err.Error())
+		return
+	}
+
+	if url := r.FormValue("url"); url != "" {
+		segments, err := backend.FetchSegments(r.Context(), url, s.sttProvider)
+		if err != nil {
+			writeJSONError(w, http.StatusBadGateway, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"text": joinSegmentText(segments)})
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "a \"file\" or \"url\" field is required")
+		return
+	}
+	defer file.Close()
+
+	if s.sttProvider == nil {
+		writeJSONError(w, http.StatusBadRequest, "no --stt-provider configured to transcribe uploaded audio")
+		return
+	}
+
+	audioPath, err := saveUploadToTemp(file)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer os.Remove(audioPath)
+
+	segments, err := s.sttProvider.Transcribe(r.Context(), audioPath)
+	if err != nil {
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"text": joinSTTSegmentText(segments)})
+}
+
+func saveUploadToTemp(src io.Reader) (string, error) {
+	f, err := os.CreateTemp("", "podscript-upload-*")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, src); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func joinSegmentText(segments []backend.CaptionSegment) string {
+	texts := make([]string, 0, len(segments))
+	for _, s := range segments {
+		texts = append(texts, s.Text)
+	}
+	return strings.Join(texts, " ")
+}
+
+func joinSTTSegmentText(segments []stt.Segment) string {
+	texts := make([]string, 0, len(segments))
+	for _, s := range segments {
+		texts = append(texts, s.Text)
+	}
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/samratjha96/podscript/backend"
+)
+
+type modelObject struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+}
+
+type modelsResponse struct {
+	Object string        `json:"object"`
+	Data   []modelObject `json:"data"`
+}
+
+// handleModels lists every model podscript can drive a transcription with,
+// in the shape OpenAI-SDK clients expect from GET /v1/models.
+func (s *Server) handleModels(w http.ResponseWriter, r *http.Request) {
+	models := backend.AllModels()
+	resp := modelsResponse{Object: "list", Data: make([]modelObject, 0, len(models))}
+	for _, m := range models {
+		resp.Data = append(resp.Data, modelObject{ID: string(m), Object: "model"})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,71 @@
+// Package server exposes podscript's transcription pipeline over HTTP, so
+// it can be driven by any OpenAI-SDK client or wired into pipelines (n8n,
+// Flowise, chat UIs) instead of shelled out to as a CLI.
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/stt"
+)
+
+// Options configures a Server.
+type Options struct {
+	// APIKey, if set, is required as a Bearer token on every request.
+	APIKey string
+	// Credentials are forwarded to backend.NewLLMClient for whichever model
+	// a request asks for.
+	Credentials backend.Credentials
+	// STTProviderName selects which stt.Provider backs the fallback used by
+	// /v1/audio/transcriptions and caption-less YouTube videos. Empty
+	// disables speech-to-text.
+	STTProviderName string
+	// STTOptions carries the extra configuration STTProviderName needs.
+	STTOptions backend.STTOptions
+}
+
+// Server exposes podscript's transcription pipeline as an OpenAI-compatible
+// HTTP API.
+type Server struct {
+	opts        Options
+	sttProvider stt.Provider
+}
+
+// New builds a Server from opts, resolving its speech-to-text provider up
+// front so a misconfigured --stt-provider fails at startup rather than on
+// the first request.
+func New(opts Options) (*Server, error) {
+	sttProvider, err := backend.NewSTTProvider(opts.STTProviderName, opts.Credentials, opts.STTOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{opts: opts, sttProvider: sttProvider}, nil
+}
+
+// Handler returns the http.Handler serving every route the server exposes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/models", s.withAuth(s.handleModels))
+	mux.HandleFunc("/v1/audio/transcriptions", s.withAuth(s.handleAudioTranscriptions))
+	mux.HandleFunc("/v1/transcripts/youtube", s.withAuth(s.handleYouTubeTranscript))
+	return mux
+}
+
+// withAuth rejects requests missing a matching `Authorization: Bearer` header
+// when opts.APIKey is set; it's a no-op otherwise.
+func (s *Server) withAuth(next http.HandlerFunc) http.HandlerFunc {
+	if s.opts.APIKey == "" {
+		return next
+	}
+	want := "Bearer " + s.opts.APIKey
+	return func(w http.ResponseWriter, r *http.Request) {
+		got := r.Header.Get("Authorization")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(want)) != 1 {
+			writeJSONError(w, http.StatusUnauthorized, "invalid API key")
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// transcriptEvent is one Server-Sent Event describing an incremental slice of
+// a cleaned-up transcript, mirroring the `func(text string, done bool) error`
+// callback backend.YouTubeTranscriber.Transcribe already streams through.
+type transcriptEvent struct {
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// sseWriter streams transcriptEvents as text/event-stream, flushing after
+// each one so clients see incremental progress rather than a buffered blob.
+type sseWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("streaming unsupported by response writer")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	return &sseWriter{w: w, flusher: flusher}, nil
+}
+
+func (s *sseWriter) send(event transcriptEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/samratjha96/podscript/backend"
+)
+
+type youtubeTranscriptRequest struct {
+	URL    string               `json:"url"`
+	Model  backend.LLMModel     `json:"model"`
+	Format backend.OutputFormat `json:"format"`
+}
+
+// handleYouTubeTranscript serves POST /v1/transcripts/youtube. With no
+// format, or format "txt", it cleans the video's captions up with Model and
+// streams the result as Server-Sent Events, mirroring the `func(text
+// string, done bool) error` callback YouTubeTranscriber.Transcribe already
+// uses internally. Any other format (srt, vtt, json) skips LLM cleanup
+// entirely and returns the raw, timestamped captions in that format as a
+// single JSON response, since an LLM rewrite doesn't preserve timestamps.
+func (s *Server) handleYouTubeTranscript(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "POST required")
+		return
+	}
+
+	var req youtubeTranscriptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if req.URL == "" {
+		writeJSONError(w, http.StatusBadRequest, "url is required")
+		return
+	}
+
+	switch req.Format {
+	case "", backend.FormatTXT:
+		s.streamCleanedYouTubeTranscript(w, r, req)
+	default:
+		s.writeRawYouTubeTranscript(w, r, req)
+	}
+}
+
+func (s *Server) writeRawYouTubeTranscript(w http.ResponseWriter, r *http.Request, req youtubeTranscriptRequest) {
+	segments, err := backend.FetchSegments(r.Context(), req.URL, s.sttProvider)
+	if err != nil {
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	formatted, err := backend.FormatSegments(segments, req.Format)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"text": formatted})
+}
+
+func (s *Server) streamCleanedYouTubeTranscript(w http.ResponseWriter, r *http.Request, req youtubeTranscriptRequest) {
+	model := req.Model
+	if model == "" {
+		model = backend.GPT4o
+	}
+
+	provider, err := backend.ProviderForModel(model)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	client, err := backend.NewLLMClient(provider, model, s.opts.Credentials)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	transcriber := backend.NewYouTubeTranscriber(client, model)
+	transcriber.STTProvider = s.sttProvider
+
+	sse, err := newSSEWriter(w)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = transcriber.Transcribe(r.Context(), req.URL, func(text string, done bool) error {
+		return sse.send(transcriptEvent{Text: text, Done: done})
+	})
+	if err != nil {
+		sse.send(transcriptEvent{Text: "error: " + err.Error(), Done: true})
+	}
+}
@@ -0,0 +1,236 @@
+// Package awstranscribe implements stt.Provider against AWS Transcribe,
+// reusing the same AWS credentials podscript already accepts for Bedrock.
+// Transcribe jobs are asynchronous and read their input from S3, so the
+// caller must provide a bucket podscript can stage the audio in.
+package awstranscribe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	"github.com/google/uuid"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+// Client adapts AWS Transcribe's batch job API to stt.Provider.
+type Client struct {
+	transcribe *transcribe.Client
+	s3         *s3.Client
+	uploader   *manager.Uploader
+	bucket     string
+}
+
+// New builds a Client from explicit AWS credentials and the S3 bucket used
+// to stage audio for each job.
+func New(region, accessKeyID, secretAccessKey, sessionToken, bucket string) *Client {
+	cfg := aws.Config{
+		Region:      region,
+		Credentials: credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, sessionToken),
+	}
+	s3Client := s3.NewFromConfig(cfg)
+	return &Client{
+		transcribe: transcribe.NewFromConfig(cfg),
+		s3:         s3Client,
+		uploader:   manager.NewUploader(s3Client),
+		bucket:     bucket,
+	}
+}
+
+func (c *Client) Transcribe(ctx context.Context, audioPath string) ([]stt.Segment, error) {
+	jobName := fmt.Sprintf("podscript-%s", uuid.NewString())
+	key := "podscript/" + jobName + filepath.Ext(audioPath)
+
+	if err := c.upload(ctx, key, audioPath); err != nil {
+		return nil, fmt.Errorf("aws transcribe: %w", err)
+	}
+	defer c.deleteObject(key)
+
+	mediaURI := fmt.Sprintf("s3://%s/%s", c.bucket, key)
+	_, err := c.transcribe.StartTranscriptionJob(ctx, &transcribe.StartTranscriptionJobInput{
+		TranscriptionJobName: aws.String(jobName),
+		LanguageCode:         types.LanguageCodeEnUs,
+		Media:                &types.Media{MediaFileUri: aws.String(mediaURI)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aws transcribe: starting job: %w", err)
+	}
+	defer c.deleteJob(jobName)
+
+	transcriptURI, err := c.waitForJob(ctx, jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchTranscript(ctx, transcriptURI)
+}
+
+// deleteObject removes the audio podscript staged in S3 for a transcription
+// job. It only logs on failure since the job itself has already
+// succeeded-or-failed by the time this runs and there's no result left to
+// return an error through.
+func (c *Client) deleteObject(key string) {
+	_, err := c.s3.DeleteObject(context.Background(), &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aws transcribe: deleting staged audio %s: %v\n", key, err)
+	}
+}
+
+// deleteJob removes the transcription job record from AWS Transcribe, which
+// otherwise keeps its metadata (and the output transcript sitting in S3)
+// around indefinitely.
+func (c *Client) deleteJob(jobName string) {
+	_, err := c.transcribe.DeleteTranscriptionJob(context.Background(), &transcribe.DeleteTranscriptionJobInput{
+		TranscriptionJobName: aws.String(jobName),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aws transcribe: deleting job %s: %v\n", jobName, err)
+	}
+}
+
+func (c *Client) upload(ctx context.Context, key, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = c.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+		Body:   f,
+	})
+	return err
+}
+
+// waitForJob polls until the transcription job finishes, returning the URI
+// of its output transcript.
+func (c *Client) waitForJob(ctx context.Context, jobName string) (string, error) {
+	for {
+		resp, err := c.transcribe.GetTranscriptionJob(ctx, &transcribe.GetTranscriptionJobInput{
+			TranscriptionJobName: aws.String(jobName),
+		})
+		if err != nil {
+			return "", fmt.Errorf("aws transcribe: polling job: %w", err)
+		}
+
+		job := resp.TranscriptionJob
+		switch job.TranscriptionJobStatus {
+		case types.TranscriptionJobStatusCompleted:
+			return aws.ToString(job.Transcript.TranscriptFileUri), nil
+		case types.TranscriptionJobStatusFailed:
+			return "", fmt.Errorf("aws transcribe: job failed: %s", aws.ToString(job.FailureReason))
+		}
+
+		select {
+		case <-time.After(5 * time.Second):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// transcribeResultItem mirrors the subset of AWS Transcribe's output JSON
+// schema podscript needs: per-word timing alongside the full text.
+type transcribeResultItem struct {
+	Type         string `json:"type"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	Alternatives []struct {
+		Content string `json:"content"`
+	} `json:"alternatives"`
+}
+
+type transcribeResult struct {
+	Results struct {
+		Items []transcribeResultItem `json:"items"`
+	} `json:"results"`
+}
+
+// fetchTranscript downloads and parses AWS Transcribe's output JSON,
+// grouping words into one segment per sentence-ending punctuation mark.
+func fetchTranscript(ctx context.Context, uri string) ([]stt.Segment, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("aws transcribe: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("aws transcribe: fetching result: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("aws transcribe: %w", err)
+	}
+
+	var parsed transcribeResult
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("aws transcribe: parsing result: %w", err)
+	}
+
+	var segments []stt.Segment
+	var text string
+	var startMs, endMs int
+	haveStart := false
+
+	flush := func() {
+		if text == "" {
+			return
+		}
+		segments = append(segments, stt.Segment{Text: text, StartMs: startMs, Duration: endMs - startMs})
+		text = ""
+		haveStart = false
+	}
+
+	for _, item := range parsed.Results.Items {
+		if len(item.Alternatives) == 0 {
+			continue
+		}
+		word := item.Alternatives[0].Content
+
+		if item.Type == "pronunciation" {
+			if !haveStart {
+				startMs = parseSeconds(item.StartTime)
+				haveStart = true
+			}
+			endMs = parseSeconds(item.EndTime)
+			if text != "" {
+				text += " "
+			}
+			text += word
+		} else {
+			// Punctuation: attach directly, then close the segment on
+			// sentence-ending marks.
+			text += word
+			if word == "." || word == "?" || word == "!" {
+				flush()
+			}
+		}
+	}
+	flush()
+
+	return segments, nil
+}
+
+func parseSeconds(s string) int {
+	seconds, _ := strconv.ParseFloat(s, 64)
+	return int(seconds * 1000)
+}
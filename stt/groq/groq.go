@@ -0,0 +1,47 @@
+// Package groq implements stt.Provider against Groq's OpenAI-compatible
+// whisper-large-v3 transcription endpoint.
+package groq
+
+import (
+	"context"
+	"fmt"
+
+	openaisdk "github.com/sashabaranov/go-openai"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+const baseURL = "https://api.groq.com/openai/v1"
+
+// Client adapts Groq's whisper-large-v3 endpoint to stt.Provider.
+type Client struct {
+	sdk *openaisdk.Client
+}
+
+// New builds a Client from a Groq API key.
+func New(apiKey string) *Client {
+	cfg := openaisdk.DefaultConfig(apiKey)
+	cfg.BaseURL = baseURL
+	return &Client{sdk: openaisdk.NewClientWithConfig(cfg)}
+}
+
+func (c *Client) Transcribe(ctx context.Context, audioPath string) ([]stt.Segment, error) {
+	resp, err := c.sdk.CreateTranscription(ctx, openaisdk.AudioRequest{
+		Model:    "whisper-large-v3",
+		FilePath: audioPath,
+		Format:   openaisdk.AudioResponseFormatVerboseJSON,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("groq whisper: %w", err)
+	}
+
+	segments := make([]stt.Segment, 0, len(resp.Segments))
+	for _, s := range resp.Segments {
+		segments = append(segments, stt.Segment{
+			Text:     s.Text,
+			StartMs:  int(s.Start * 1000),
+			Duration: int((s.End - s.Start) * 1000),
+		})
+	}
+	return segments, nil
+}
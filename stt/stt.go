@@ -0,0 +1,18 @@
+// Package stt defines the pluggable speech-to-text contract podscript uses
+// to transcribe a video's audio when YouTube has no auto-generated
+// captions for it.
+package stt
+
+import "context"
+
+// Segment is one timestamped unit of a speech-to-text transcription.
+type Segment struct {
+	Text     string
+	StartMs  int
+	Duration int
+}
+
+// Provider transcribes an audio file into timestamped segments.
+type Provider interface {
+	Transcribe(ctx context.Context, audioPath string) ([]Segment, error)
+}
@@ -0,0 +1,43 @@
+// Package whisper implements stt.Provider against the OpenAI Whisper
+// transcription API.
+package whisper
+
+import (
+	"context"
+	"fmt"
+
+	openaisdk "github.com/sashabaranov/go-openai"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+// Client adapts the OpenAI Whisper API to stt.Provider.
+type Client struct {
+	sdk *openaisdk.Client
+}
+
+// New builds a Client from an OpenAI API key.
+func New(apiKey string) *Client {
+	return &Client{sdk: openaisdk.NewClient(apiKey)}
+}
+
+func (c *Client) Transcribe(ctx context.Context, audioPath string) ([]stt.Segment, error) {
+	resp, err := c.sdk.CreateTranscription(ctx, openaisdk.AudioRequest{
+		Model:    openaisdk.Whisper1,
+		FilePath: audioPath,
+		Format:   openaisdk.AudioResponseFormatVerboseJSON,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("whisper: %w", err)
+	}
+
+	segments := make([]stt.Segment, 0, len(resp.Segments))
+	for _, s := range resp.Segments {
+		segments = append(segments, stt.Segment{
+			Text:     s.Text,
+			StartMs:  int(s.Start * 1000),
+			Duration: int((s.End - s.Start) * 1000),
+		})
+	}
+	return segments, nil
+}
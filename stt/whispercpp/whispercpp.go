@@ -0,0 +1,77 @@
+// Package whispercpp implements stt.Provider by shelling out to a local
+// whisper.cpp binary, for fully offline transcription.
+package whispercpp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/samratjha96/podscript/stt"
+)
+
+// Client invokes a local whisper.cpp binary to transcribe audio.
+type Client struct {
+	// BinaryPath is the path to whisper.cpp's main/whisper-cli binary.
+	BinaryPath string
+	// ModelPath is the path to a whisper.cpp ggml model file.
+	ModelPath string
+}
+
+// New builds a Client from the paths to the whisper.cpp binary and model.
+func New(binaryPath, modelPath string) *Client {
+	return &Client{BinaryPath: binaryPath, ModelPath: modelPath}
+}
+
+type whisperCppOutput struct {
+	Transcription []struct {
+		Offsets struct {
+			From int `json:"from"`
+			To   int `json:"to"`
+		} `json:"offsets"`
+		Text string `json:"text"`
+	} `json:"transcription"`
+}
+
+func (c *Client) Transcribe(ctx context.Context, audioPath string) ([]stt.Segment, error) {
+	outPrefix, err := os.CreateTemp("", "podscript-whispercpp-*")
+	if err != nil {
+		return nil, fmt.Errorf("whispercpp: %w", err)
+	}
+	outPrefix.Close()
+	defer os.Remove(outPrefix.Name())
+	defer os.Remove(outPrefix.Name() + ".json")
+
+	cmd := exec.CommandContext(ctx, c.BinaryPath,
+		"-m", c.ModelPath,
+		"-f", audioPath,
+		"-oj",
+		"-of", outPrefix.Name(),
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("whispercpp: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	data, err := os.ReadFile(outPrefix.Name() + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("whispercpp: reading output: %w", err)
+	}
+
+	var parsed whisperCppOutput
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("whispercpp: parsing output: %w", err)
+	}
+
+	segments := make([]stt.Segment, 0, len(parsed.Transcription))
+	for _, s := range parsed.Transcription {
+		segments = append(segments, stt.Segment{
+			Text:     strings.TrimSpace(s.Text),
+			StartMs:  s.Offsets.From,
+			Duration: s.Offsets.To - s.Offsets.From,
+		})
+	}
+	return segments, nil
+}
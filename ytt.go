@@ -4,64 +4,79 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
+
+	"github.com/samratjha96/podscript/backend"
+	"github.com/samratjha96/podscript/jobstore"
+	"github.com/samratjha96/podscript/stt"
 )
 
 type YTTCmd struct {
-	OpenAIAPIKey       string   `name:"openai-api-key" help:"OpenAI API key" env:"OPENAI_API_KEY" hidden:""`
-	AnthropicAPIKey    string   `help:"Anthropic API key" env:"ANTHROPIC_API_KEY" hidden:""`
-	GroqAPIKey         string   `help:"Groq API key" env:"GROQ_API_KEY" hidden:""`
-	GeminiAPIKey       string   `help:"Gemini API key" env:"GEMINI_API_KEY" hidden:""`
-	AWSRegion          string   `help:"AWS Region" env:"AWS_REGION" hidden:""`
-	AWSAccessKeyID     string   `help:"AWS Access Key ID" env:"AWS_ACCESS_KEY_ID" hidden:""`
-	AWSSecretAccessKey string   `help:"AWS Secret Access Key ID" env:"AWS_SECRET_ACCESS_KEY" hidden:""`
-	AWSSessionToken    string   `help:"AWS Session Token" env:"AWS_SESSION_TOKEN" hidden:""`
-	Model              LLMModel `help:"Model to use" default:"gpt-4o" short:"m"`
-	VideoURL           string   `arg:"" help:"YouTube video URL" short:"u"`
-	Output             string   `help:"Path to output transcript file (default: stdout)" short:"o"`
+	OpenAIAPIKey        string               `name:"openai-api-key" help:"OpenAI API key" env:"OPENAI_API_KEY" hidden:""`
+	AnthropicAPIKey     string               `help:"Anthropic API key" env:"ANTHROPIC_API_KEY" hidden:""`
+	GroqAPIKey          string               `help:"Groq API key" env:"GROQ_API_KEY" hidden:""`
+	GeminiAPIKey        string               `help:"Gemini API key" env:"GEMINI_API_KEY" hidden:""`
+	AWSRegion           string               `help:"AWS Region" env:"AWS_REGION" hidden:""`
+	AWSAccessKeyID      string               `help:"AWS Access Key ID" env:"AWS_ACCESS_KEY_ID" hidden:""`
+	AWSSecretAccessKey  string               `help:"AWS Secret Access Key ID" env:"AWS_SECRET_ACCESS_KEY" hidden:""`
+	AWSSessionToken     string               `help:"AWS Session Token" env:"AWS_SESSION_TOKEN" hidden:""`
+	Model               backend.LLMModel     `help:"Model to use" default:"gpt-4o" short:"m"`
+	Concurrency         int                  `help:"Number of chunks to clean up in parallel" default:"4" short:"c"`
+	MaxElapsed          time.Duration        `name:"max-elapsed" help:"Give up on the whole transcription after this long" default:"10m"`
+	VideoURL            string               `arg:"" help:"YouTube video URL" short:"u"`
+	Output              string               `help:"Path to output transcript file (default: stdout)" short:"o"`
+	Raw                 bool                 `help:"Write the raw transcript instead of cleaning it up with an LLM"`
+	Format              backend.OutputFormat `help:"Raw transcript format: txt, srt, vtt, or json" default:"txt"`
+	STTProvider         string               `name:"stt-provider" help:"Speech-to-text provider to fall back to when the video has no captions: whisper, groq, aws-transcribe, or whispercpp"`
+	AWSTranscribeBucket string               `name:"aws-transcribe-bucket" help:"S3 bucket to stage jobs in for --stt-provider aws-transcribe"`
+	WhisperCppBinary    string               `name:"whispercpp-binary" help:"Path to the whisper.cpp binary for --stt-provider whispercpp"`
+	WhisperCppModel     string               `name:"whispercpp-model" help:"Path to the whisper.cpp model for --stt-provider whispercpp"`
+	Job                 bool                 `help:"Record this transcription as a resumable job in the jobs store, so a failure partway through can be continued with 'podscript jobs resume' instead of rerun from scratch"`
 }
 
-func (cmd *YTTCmd) getLLMClient() (LLMClient, error) {
-	var provider LLMProvider
+func (cmd *YTTCmd) credentials() backend.Credentials {
+	return backend.Credentials{
+		OpenAIAPIKey:       cmd.OpenAIAPIKey,
+		AnthropicAPIKey:    cmd.AnthropicAPIKey,
+		GroqAPIKey:         cmd.GroqAPIKey,
+		GeminiAPIKey:       cmd.GeminiAPIKey,
+		AWSRegion:          cmd.AWSRegion,
+		AWSAccessKeyID:     cmd.AWSAccessKeyID,
+		AWSSecretAccessKey: cmd.AWSSecretAccessKey,
+		AWSSessionToken:    cmd.AWSSessionToken,
+	}
+}
+
+func (cmd *YTTCmd) sttProvider() (stt.Provider, error) {
+	return backend.NewSTTProvider(cmd.STTProvider, cmd.credentials(), backend.STTOptions{
+		AWSTranscribeBucket: cmd.AWSTranscribeBucket,
+		WhisperCppBinary:    cmd.WhisperCppBinary,
+		WhisperCppModel:     cmd.WhisperCppModel,
+	})
+}
+
+func (cmd *YTTCmd) getLLMClient() (backend.LLMClient, error) {
+	var provider backend.LLMProvider
 
 	switch cmd.Model {
-	case GPT4o, GPT4oMini:
-		if cmd.OpenAIAPIKey == "" {
-			return nil, fmt.Errorf("OpenAI API key required for model %s", cmd.Model)
-		}
-		provider = OpenAI
-	case Claude35Sonnet, Claude35Haiku:
-		if cmd.AnthropicAPIKey == "" {
-			return nil, fmt.Errorf("Anthropic API key required for model %s", cmd.Model)
-		}
-		provider = Claude
-	case Llama3370b, Llama318b:
-		if cmd.GroqAPIKey == "" {
-			return nil, fmt.Errorf("Groq API key required for model %s", cmd.Model)
-		}
-		provider = Groq
-	case Gemini2Flash:
-		if cmd.GeminiAPIKey == "" {
-			return nil, fmt.Errorf("Gemini API key required for model %s", cmd.Model)
-		}
-		provider = Gemini
-	case BedrockClaude35Sonnet, BedrockClaude35Haiku:
-		if cmd.AWSRegion == "" || cmd.AWSAccessKeyID == "" || cmd.AWSSecretAccessKey == "" || cmd.AWSSessionToken == "" {
-			return nil, fmt.Errorf("AWS credentials required for model %s. Run 'podscript configure' to set them up", cmd.Model)
-		}
-		provider = Bedrock
+	case backend.GPT4o, backend.GPT4oMini:
+		provider = backend.OpenAI
+	case backend.Claude35Sonnet, backend.Claude35Haiku:
+		provider = backend.Claude
+	case backend.Llama3370b, backend.Llama318b:
+		provider = backend.Groq
+	case backend.Gemini2Flash:
+		provider = backend.Gemini
+	case backend.BedrockClaude35Sonnet, backend.BedrockClaude35Haiku:
+		provider = backend.Bedrock
 	default:
 		return nil, fmt.Errorf("unsupported model: %s", cmd.Model)
 	}
 
-	return NewLLMClient(provider, cmd)
+	return backend.NewLLMClient(provider, cmd.Model, cmd.credentials())
 }
 
 func (cmd *YTTCmd) Run() error {
-	client, err := cmd.getLLMClient()
-	if err != nil {
-		return err
-	}
-
 	out := os.Stdout
 	if cmd.Output != "" {
 		f, err := os.Create(cmd.Output)
@@ -72,7 +87,37 @@ func (cmd *YTTCmd) Run() error {
 		out = f
 	}
 
-	transcriber := NewYouTubeTranscriber(client, cmd.Model)
+	sttProvider, err := cmd.sttProvider()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Raw {
+		segments, err := backend.FetchSegments(context.Background(), cmd.VideoURL, sttProvider)
+		if err != nil {
+			return err
+		}
+		formatted, err := backend.FormatSegments(segments, cmd.Format)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(out, formatted)
+		return err
+	}
+
+	client, err := cmd.getLLMClient()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Job {
+		return cmd.runAsJob(client, sttProvider, out)
+	}
+
+	transcriber := backend.NewYouTubeTranscriber(client, cmd.Model)
+	transcriber.Concurrency = cmd.Concurrency
+	transcriber.MaxElapsed = cmd.MaxElapsed
+	transcriber.STTProvider = sttProvider
 	err = transcriber.Transcribe(context.Background(), cmd.VideoURL,
 		func(text string, done bool) error {
 			_, err := fmt.Fprint(out, text)
@@ -81,3 +126,68 @@ func (cmd *YTTCmd) Run() error {
 	fmt.Println()
 	return err
 }
+
+// runAsJob records the transcription in the jobs store before cleaning any
+// chunks up, so a rate-limit or network failure partway through can be
+// continued later with 'podscript jobs resume <id>' instead of redownloading
+// captions and re-cleaning chunks that already succeeded.
+func (cmd *YTTCmd) runAsJob(client backend.LLMClient, sttProvider stt.Provider, out *os.File) error {
+	ctx := context.Background()
+
+	chunks, err := backend.PrepareChunks(ctx, cmd.VideoURL, cmd.Model, sttProvider)
+	if err != nil {
+		return err
+	}
+
+	store, err := openJobStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	job, err := store.CreateJob(ctx, cmd.VideoURL, string(cmd.Model), chunks)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created job %d\n", job.ID)
+
+	transcriber := backend.NewYouTubeTranscriber(client, cmd.Model)
+	transcriber.Concurrency = cmd.Concurrency
+	transcriber.MaxElapsed = cmd.MaxElapsed
+
+	ctx, cancel := transcriber.BoundContext(ctx)
+	defer cancel()
+
+	results := transcriber.ProcessChunks(ctx, chunks)
+
+	failed := 0
+	var acc string
+	for i, res := range results {
+		if err := store.UpdateChunk(ctx, job.ID, i, res.Text, res.Err); err != nil {
+			return err
+		}
+		if res.Err != nil {
+			failed++
+			continue
+		}
+		acc = backend.MergeTranscriptText(acc, res.Text)
+	}
+
+	status := jobstore.StatusDone
+	if failed > 0 {
+		status = jobstore.StatusFailed
+	}
+	if err := store.SetJobStatus(ctx, job.ID, status); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprint(out, acc); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d chunks failed; run 'podscript jobs resume %d' to retry", failed, len(results), job.ID)
+	}
+	return nil
+}